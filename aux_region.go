@@ -28,11 +28,11 @@ import (
 )
 
 type auxInternal struct {
-	ConsumedWeight          *float64    `cbor:"0,keyasint,omitempty" yaml:"consumed_weight,omitempty" opt:"name=consumed_weight,key=0"`
-	Workgroup               *string     `cbor:"1,keyasint,omitempty" yaml:"workgroup,omitempty" opt:"name=workgroup,key=1,max_length=8"`
-	GeneralPurposeRangeUser *string     `cbor:"2,keyasint,omitempty" yaml:"general_purpose_range_user,omitempty" opt:"name=general_purpose_range_user,key=2,max_length=8"`
-	LastStirTime            *uint64     `cbor:"3,keyasint,omitempty" yaml:"last_stir_time,omitempty" opt:"name=last_stir_time,key=3"`
-	Unknowns                map[any]any `cbor:"-" yaml:"other,omitempty"`
+	ConsumedWeight          *float64    `cbor:"0,keyasint,omitempty" yaml:"consumed_weight,omitempty" json:"consumed_weight,omitempty" opt:"name=consumed_weight,key=0"`
+	Workgroup               *string     `cbor:"1,keyasint,omitempty" yaml:"workgroup,omitempty" json:"workgroup,omitempty" opt:"name=workgroup,key=1,max_length=8"`
+	GeneralPurposeRangeUser *string     `cbor:"2,keyasint,omitempty" yaml:"general_purpose_range_user,omitempty" json:"general_purpose_range_user,omitempty" opt:"name=general_purpose_range_user,key=2,max_length=8"`
+	LastStirTime            *uint64     `cbor:"3,keyasint,omitempty" yaml:"last_stir_time,omitempty" json:"last_stir_time,omitempty" opt:"name=last_stir_time,key=3"`
+	Unknowns                map[any]any `cbor:"-" yaml:"other,omitempty" json:"-"`
 }
 
 type AuxRegion struct {
@@ -40,13 +40,46 @@ type AuxRegion struct {
 	regionOptions *RegionOptions
 }
 
+// auxFieldInfo lists all aux region fields defined by the specification
+var auxFieldInfo = []FieldInfo{
+	{
+		Region: "aux",
+		Key:    0,
+		Name:   "consumed_weight",
+		Type:   FieldTypeNumber,
+		Unit:   "g",
+	},
+	{
+		Region:    "aux",
+		Key:       1,
+		Name:      "workgroup",
+		Type:      FieldTypeString,
+		MaxLength: 8,
+	},
+	{
+		Region:    "aux",
+		Key:       2,
+		Name:      "general_purpose_range_user",
+		Type:      FieldTypeString,
+		MaxLength: 8,
+	},
+	{
+		Region: "aux",
+		Key:    3,
+		Name:   "last_stir_time",
+		Type:   FieldTypeTimestamp,
+	},
+}
+
 // SetConsumedWeight Sets the value of consumed_weight (0)
+// Unit: g
 func (s *AuxRegion) SetConsumedWeight(value float64) *AuxRegion {
 	s.internal.ConsumedWeight = &value
 	return s
 }
 
 // GetConsumedWeight Gets the value of consumed_weight (0)
+// Unit: g
 func (s *AuxRegion) GetConsumedWeight() (float64, bool) {
 	if s.internal.ConsumedWeight != nil {
 		return *s.internal.ConsumedWeight, true
@@ -55,6 +88,7 @@ func (s *AuxRegion) GetConsumedWeight() (float64, bool) {
 }
 
 // ClearConsumedWeight Clears the value of consumed_weight (0)
+// Unit: g
 func (s *AuxRegion) ClearConsumedWeight() *AuxRegion {
 	s.internal.ConsumedWeight = nil
 	return s
@@ -132,6 +166,24 @@ func (s *AuxRegion) GetUnknownFields() map[any]any {
 	return s.internal.Unknowns
 }
 
+func (s auxInternal) MarshalJSON() ([]byte, error) {
+	// The plain type drops these methods so the standard encoder handles the known fields
+	type plain auxInternal
+	return marshalJSONWithUnknowns(plain(s), s.Unknowns)
+}
+
+func (s *auxInternal) UnmarshalJSON(data []byte) error {
+	type plain auxInternal
+	var known plain
+	unknowns, err := unmarshalJSONWithUnknowns(data, &known)
+	if err != nil {
+		return err
+	}
+	*s = auxInternal(known)
+	s.Unknowns = unknowns
+	return nil
+}
+
 func (s AuxRegion) getInternal() any {
 	return &s.internal
 }
@@ -25,7 +25,9 @@ package openprinttag
 import (
 	"bytes"
 	"cmp"
+	"encoding/binary"
 	"fmt"
+	"math"
 	"reflect"
 	"slices"
 	"strconv"
@@ -44,6 +46,28 @@ const (
 	emptyIndefiniteMapByte2 = byte(0xff)
 )
 
+const (
+	cborMajorTypeArray = byte(4)
+	cborMajorTypeMap   = byte(5)
+	cborMajorTypeOther = byte(7)
+
+	cborIndefiniteArrayByte = byte(0x9f)
+
+	cborFloat16Byte = byte(0xf9)
+	cborFloat32Byte = byte(0xfa)
+	cborFloat64Byte = byte(0xfb)
+)
+
+// floatEncoding records how a floating point field was encoded in a decoded tag
+type floatEncoding int
+
+const (
+	floatEncodingInteger floatEncoding = iota
+	floatEncodingFloat16
+	floatEncodingFloat32
+	floatEncodingFloat64
+)
+
 type reflectionMapItem struct {
 	field *reflect.Value
 	name  string
@@ -54,10 +78,10 @@ type reflectionMapItem struct {
 // Depending on encoding options, we use definite or indefinite form for containers
 func encodeToCBOR(r Region) (data []byte, err error) {
 	if r.RegionOptions().cborContainerType == CBORContainerTypeDefinite {
-		data, err = encodeAsDefiniteMap(r)
+		data, err = encodeRegionMap(r, false)
 	} else {
 		// CBORContainerTypeIndefinite or CBORContainerTypeAuto
-		data, err = encodeAsIndefiniteMap(r)
+		data, err = encodeRegionMap(r, true)
 		if len(data) == 2 {
 			if data[0] == emptyIndefiniteMapByte1 && data[1] == emptyIndefiniteMapByte2 {
 				// Well that is an empy indefinite container
@@ -70,13 +94,38 @@ func encodeToCBOR(r Region) (data []byte, err error) {
 		}
 	}
 	if len(data) > maxRegionSize {
-		err = fmt.Errorf("region %s size of %d exceeds maximum permissable size of %d bytes", r.getRegionName(), len(data), maxRegionSize)
+		err = &EncodeError{
+			Reason:  ReasonRegionTooLarge,
+			Layer:   regionLayer(r),
+			Offset:  -1,
+			Message: fmt.Sprintf("region %s size of %d exceeds maximum permissable size of %d bytes", r.getRegionName(), len(data), maxRegionSize),
+		}
 	}
 	return
 }
 
-// encodeAsIndefiniteMap will encode a region using an indefinite map
-func encodeAsIndefiniteMap(r Region) ([]byte, error) {
+// regionLayer returns the error layer corresponding to a region
+func regionLayer(r Region) ErrorLayer {
+	switch r.getRegionName() {
+	case "meta":
+		return LayerMeta
+	case "aux":
+		return LayerAux
+	default:
+		return LayerMain
+	}
+}
+
+// regionEntry is a single key/value pair to be encoded into a region map
+type regionEntry struct {
+	key   any
+	value any
+}
+
+// encodeRegionMap will encode a region using an indefinite or definite map
+// Containers within the region (arrays) follow the same form as the map, unless
+// the region was decoded with the array in the other form
+func encodeRegionMap(r Region, indefinite bool) ([]byte, error) {
 	encmode, err := cbor.EncOptions{ShortestFloat: cbor.ShortestFloat16}.EncMode()
 	if err != nil {
 		return nil, err
@@ -84,111 +133,135 @@ func encodeAsIndefiniteMap(r Region) ([]byte, error) {
 	var buf bytes.Buffer
 
 	enc := encmode.NewEncoder(&buf)
-	internal := reflect.ValueOf(r.getInternal()).Elem()
-
-	theMap := mapRegion(&internal)
-
-	if err = enc.StartIndefiniteMap(); err != nil {
+	entries, err := getRegionEntries(r)
+	if err != nil {
 		return nil, err
 	}
-	for _, info := range getSortedFieldsToEncode(theMap) {
-		if err = enc.Encode(info.key); err != nil {
+
+	if indefinite {
+		if err = enc.StartIndefiniteMap(); err != nil {
 			return nil, err
 		}
-		// Now encode the value depending on its type
-		kind := info.field.Elem().Type().Kind()
-		switch kind {
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-			if err = enc.Encode(info.field.Elem().Interface()); err != nil {
-				return nil, err
-			}
-		case reflect.Float32, reflect.Float64:
-			if err = enc.Encode(compressFloat(info.field.Elem().Interface(), r.RegionOptions())); err != nil {
-				return nil, err
-			}
-		case reflect.String:
-			if err = enc.Encode(info.field.Elem().Interface()); err != nil {
+	} else {
+		writeCBORHead(&buf, cborMajorTypeMap, len(entries))
+	}
+
+	for _, entry := range entries {
+		if err = enc.Encode(entry.key); err != nil {
+			return nil, err
+		}
+
+		// Slices (other than byte strings) follow the container form of the region,
+		// or the form they were decoded in
+		value := reflect.ValueOf(entry.value)
+		indefiniteArray := indefinite
+		if form, found := r.RegionOptions().indefiniteArrays[layoutKey(entry.key)]; found {
+			indefiniteArray = form
+		}
+		if indefiniteArray && value.Kind() == reflect.Slice && value.Type().Elem().Kind() != reflect.Uint8 {
+			if err = enc.StartIndefiniteArray(); err != nil {
 				return nil, err
 			}
-		case reflect.Slice, reflect.Array:
-			kind := info.field.Elem().Type().Elem().Kind()
-			if kind == reflect.Uint8 {
-				// This is a []byte and must be encoded as a byte string
-				if err = enc.Encode(info.field.Elem().Interface()); err != nil {
-					return nil, err
-				}
-			} else {
-				// This is a []..something else and must be encoded as an indefinite array
-				if err = enc.StartIndefiniteArray(); err != nil {
-					return nil, err
-				}
-				for n := 0; n < info.field.Elem().Len(); n++ {
-					sliceContent := info.field.Elem().Index(n).Interface()
-					if err = enc.Encode(sliceContent); err != nil {
-						return nil, err
-					}
-				}
-				if err = enc.EndIndefinite(); err != nil {
+			for n := 0; n < value.Len(); n++ {
+				if err = enc.Encode(value.Index(n).Interface()); err != nil {
 					return nil, err
 				}
 			}
-		default:
-			return nil, fmt.Errorf("cannot encode %s", kind)
+			if err = enc.EndIndefinite(); err != nil {
+				return nil, err
+			}
+			continue
 		}
-	}
 
-	// Add any unknowns back in
-	for key, value := range r.GetUnknownFields() {
-		if err = enc.Encode(key); err != nil {
-			return nil, err
-		}
-		if err = enc.Encode(value); err != nil {
+		if err = enc.Encode(entry.value); err != nil {
 			return nil, err
 		}
 	}
 
-	if err = enc.EndIndefinite(); err != nil {
-		return nil, err
+	if indefinite {
+		if err = enc.EndIndefinite(); err != nil {
+			return nil, err
+		}
 	}
 
 	return buf.Bytes(), nil
 }
 
-// encodeAsDefiniteMap will encode a region using an definite map
-func encodeAsDefiniteMap(r Region) ([]byte, error) {
-
-	encmode, err := cbor.EncOptions{ShortestFloat: cbor.ShortestFloat16}.EncMode()
-	if err != nil {
-		return nil, err
+// layoutKey returns the form in which a region key is recorded in the decoded layout,
+// where known field keys are held as int but decoded as uint64
+func layoutKey(key any) any {
+	if keyInt, ok := key.(int); ok && keyInt >= 0 {
+		return uint64(keyInt)
 	}
-	var buf bytes.Buffer
+	return key
+}
 
-	enc := encmode.NewEncoder(&buf)
+// getRegionEntries returns all key/value pairs (known and unknown) to be encoded
+// for a region. Where the region was decoded, the original key order is retained
+// with any newly set fields following in key order
+func getRegionEntries(r Region) ([]regionEntry, error) {
 	internal := reflect.ValueOf(r.getInternal()).Elem()
-
 	theMap := mapRegion(&internal)
+	unknowns := r.GetUnknownFields()
+	opts := r.RegionOptions()
 
-	mapToEncode := make(map[any]any)
+	var entries []regionEntry
+	knownDone := make(map[int]bool)
+	unknownDone := make(map[any]bool)
 
-	for _, info := range getSortedFieldsToEncode(theMap) {
-		if info.field.Elem().Type().Kind() == reflect.Float32 {
-			mapToEncode[info.key] = compressFloat(info.field.Elem().Interface(), r.RegionOptions())
-		} else {
-			mapToEncode[info.key] = info.field.Elem().Interface()
+	addKnown := func(info reflectionMapItem) error {
+		value := info.field.Elem().Interface()
+		kind := info.field.Elem().Type().Kind()
+		switch kind {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.String, reflect.Slice, reflect.Array:
+		case reflect.Float32, reflect.Float64:
+			value = encodeFloatField(uint64(info.key), value, opts)
+		default:
+			return fmt.Errorf("cannot encode %s", kind)
 		}
+		entries = append(entries, regionEntry{key: info.key, value: value})
+		knownDone[info.key] = true
+		return nil
 	}
 
-	// Add any unknowns back in
-	for key, value := range r.GetUnknownFields() {
-		mapToEncode[key] = value
+	// Firstly, anything that was present when the region was decoded, in the original order
+	for _, key := range opts.keyOrder {
+		if keyInt, ok := key.(uint64); ok {
+			if info, found := theMap[int(keyInt)]; found {
+				if !info.field.IsNil() {
+					if err := addKnown(info); err != nil {
+						return nil, err
+					}
+				}
+				continue
+			}
+		}
+		if value, found := unknowns[key]; found {
+			entries = append(entries, regionEntry{key: key, value: value})
+			unknownDone[key] = true
+		}
 	}
 
-	if err = enc.Encode(mapToEncode); err != nil {
-		return nil, err
+	// Then any remaining known fields, in key order
+	for _, info := range getSortedFieldsToEncode(theMap) {
+		if knownDone[info.key] {
+			continue
+		}
+		if err := addKnown(info); err != nil {
+			return nil, err
+		}
 	}
 
-	return buf.Bytes(), nil
+	// Finally, any remaining unknowns
+	for _, key := range sortedUnknownKeys(unknowns) {
+		if !unknownDone[key] {
+			entries = append(entries, regionEntry{key: key, value: unknowns[key]})
+		}
+	}
+
+	return entries, nil
 }
 
 // getSortedFieldsToEncode takes our map of the region fields
@@ -296,3 +369,164 @@ func compressFloat(orig any, opts *RegionOptions) any {
 
 	}
 }
+
+// encodeFloatField returns the value to be encoded for a floating point field
+// Where the field was decoded from an existing tag, the original width is reused
+// as long as the value is exactly representable in it, otherwise we fall back to
+// compressFloat
+func encodeFloatField(key uint64, orig any, opts *RegionOptions) any {
+	encoding, found := opts.floatEncodings[key]
+	if !found {
+		return compressFloat(orig, opts)
+	}
+
+	var original float64
+	if f64, ok := orig.(float64); ok {
+		original = f64
+	} else if f32, ok := orig.(float32); ok {
+		original = float64(f32)
+	}
+
+	switch encoding {
+	case floatEncodingFloat16:
+		f16 := float16.Fromfloat32(float32(original))
+		if float64(f16.Float32()) == original {
+			return cbor.RawMessage{cborFloat16Byte, byte(f16 >> 8), byte(f16)}
+		}
+	case floatEncodingFloat32:
+		if float64(float32(original)) == original {
+			raw := make([]byte, 5)
+			raw[0] = cborFloat32Byte
+			binary.BigEndian.PutUint32(raw[1:], math.Float32bits(float32(original)))
+			return cbor.RawMessage(raw)
+		}
+	case floatEncodingFloat64:
+		raw := make([]byte, 9)
+		raw[0] = cborFloat64Byte
+		binary.BigEndian.PutUint64(raw[1:], math.Float64bits(original))
+		return cbor.RawMessage(raw)
+	}
+
+	// Integers (and anything that no longer fits the original width) are
+	// compressed as normal
+	return compressFloat(orig, opts)
+}
+
+// writeCBORHead writes a CBOR data item head for the given major type and argument
+func writeCBORHead(buf *bytes.Buffer, majorType byte, argument int) {
+	mt := majorType << 5
+	switch {
+	case argument < 24:
+		buf.WriteByte(mt | byte(argument))
+	case argument <= math.MaxUint8:
+		buf.WriteByte(mt | 24)
+		buf.WriteByte(byte(argument))
+	case argument <= math.MaxUint16:
+		buf.WriteByte(mt | 25)
+		buf.Write(binary.BigEndian.AppendUint16(nil, uint16(argument)))
+	default:
+		buf.WriteByte(mt | 26)
+		buf.Write(binary.BigEndian.AppendUint32(nil, uint32(argument)))
+	}
+}
+
+// decodeRegionLayout walks the top level map of an encoded region and records
+// into the region options the container form used, the order in which keys appear
+// and the width of any floating point (or integer encoded floating point) fields
+// so that the region can later be re-encoded identically
+func decodeRegionLayout(raw []byte, floatKeys map[uint64]bool, opts *RegionOptions) error {
+	if len(raw) == 0 {
+		return fmt.Errorf("empty region")
+	}
+
+	indefinite := raw[0] == emptyIndefiniteMapByte1
+	if indefinite {
+		opts.cborContainerType = CBORContainerTypeIndefinite
+	} else if !(raw[0] == emptyDefiniteMap && opts.cborContainerType == CBORContainerTypeAuto) {
+		// An empty definite map is what auto would produce anyway, so leave auto alone
+		// in that case, allowing fields added later to use indefinite form as normal
+		opts.cborContainerType = CBORContainerTypeDefinite
+	}
+
+	// For definite maps, the head tells us the number of entries
+	var count int
+	rest := raw[1:]
+	if !indefinite {
+		if raw[0]>>5 != cborMajorTypeMap {
+			return fmt.Errorf("region is not a CBOR map")
+		}
+		count = int(raw[0] & 0x1f)
+		argLen := 0
+		switch count {
+		case 24:
+			argLen = 1
+		case 25:
+			argLen = 2
+		case 26:
+			argLen = 4
+		}
+		if argLen > 0 {
+			if len(raw) < 1+argLen {
+				return fmt.Errorf("truncated CBOR map head")
+			}
+			count = 0
+			for _, b := range raw[1 : 1+argLen] {
+				count = count<<8 | int(b)
+			}
+			rest = raw[1+argLen:]
+		}
+	}
+
+	opts.keyOrder = nil
+	opts.floatEncodings = make(map[uint64]floatEncoding)
+	opts.indefiniteArrays = make(map[any]bool)
+	for n := 0; indefinite || n < count; n++ {
+		if indefinite && len(rest) > 0 && rest[0] == emptyIndefiniteMapByte2 {
+			break
+		}
+
+		var key any
+		var err error
+		if rest, err = cbor.UnmarshalFirst(rest, &key); err != nil {
+			return err
+		}
+		var value cbor.RawMessage
+		if rest, err = cbor.UnmarshalFirst(rest, &value); err != nil {
+			return err
+		}
+		opts.keyOrder = append(opts.keyOrder, key)
+		if len(value) > 0 && value[0]>>5 == cborMajorTypeArray {
+			opts.indefiniteArrays[key] = value[0] == cborIndefiniteArrayByte
+		}
+
+		keyInt, ok := key.(uint64)
+		if !ok || !floatKeys[keyInt] || len(value) == 0 {
+			continue
+		}
+		switch {
+		case value[0] == cborFloat16Byte:
+			opts.floatEncodings[keyInt] = floatEncodingFloat16
+		case value[0] == cborFloat32Byte:
+			opts.floatEncodings[keyInt] = floatEncodingFloat32
+		case value[0] == cborFloat64Byte:
+			opts.floatEncodings[keyInt] = floatEncodingFloat64
+		case value[0]>>5 != cborMajorTypeOther:
+			opts.floatEncodings[keyInt] = floatEncodingInteger
+		}
+	}
+	return nil
+}
+
+// floatFieldKeys returns the set of keys within a region internal struct that
+// hold floating point values
+func floatFieldKeys(internal any) map[uint64]bool {
+	value := reflect.Indirect(reflect.ValueOf(internal))
+	keys := make(map[uint64]bool)
+	for key, info := range mapRegion(&value) {
+		kind := info.field.Type().Elem().Kind()
+		if kind == reflect.Float32 || kind == reflect.Float64 {
+			keys[uint64(key)] = true
+		}
+	}
+	return keys
+}
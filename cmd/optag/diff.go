@@ -0,0 +1,92 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/cjbearman/openprinttag"
+	"gopkg.in/yaml.v3"
+)
+
+// diffMain implements the "optag diff a b" sub-command, comparing two tags
+// field by field. Each tag may be a binary tag or a YAML data file
+func diffMain(args []string) {
+	fs := flag.NewFlagSet("optag diff", flag.ExitOnError)
+	var useJSON, diffNoCC, diffType2 bool
+	fs.BoolVar(&useJSON, "json", false, "Output differences as JSON instead of YAML")
+	fs.BoolVar(&diffNoCC, "no-cc", false, "Disable capability container decoding for binary tags")
+	fs.BoolVar(&diffType2, "type2", false, "Decode binary tags using the NFC Forum Type 2 (NTAG21x) layout")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage of optag diff: optag diff [options] <tag a> <tag b>\n")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		terminal(errors.New("diff requires exactly two tags to compare"))
+	}
+
+	ecOpts := []openprinttag.EncodeDecodeOption{}
+	if diffNoCC {
+		ecOpts = append(ecOpts, openprinttag.WithoutCapabilityContainer)
+	}
+	if diffType2 {
+		ecOpts = append(ecOpts, openprinttag.Type2Layout)
+	}
+
+	a := loadAnyTag(fs.Arg(0), ecOpts)
+	b := loadAnyTag(fs.Arg(1), ecOpts)
+	diff := openprinttag.Diff(a, b)
+
+	var output []byte
+	var err error
+	if useJSON {
+		output, err = json.MarshalIndent(diff, "", "  ")
+		output = append(output, '\n')
+	} else {
+		output, err = yaml.Marshal(diff)
+	}
+	if err != nil {
+		terminal(fmt.Errorf("failed to format differences: %w", err))
+	}
+	writeOutput("", output)
+}
+
+// loadAnyTag loads a tag from a file (or "-" for STDIN) which may contain either a
+// binary tag or YAML data
+func loadAnyTag(filename string, ecOpts []openprinttag.EncodeDecodeOption) *openprinttag.OpenPrintTag {
+	data := loadTag(filename)
+	tag, binErr := openprinttag.Decode(data, ecOpts...)
+	if binErr == nil {
+		return tag
+	}
+	tag, yamlErr := openprinttag.FromYAML(string(data))
+	if yamlErr != nil {
+		terminal(fmt.Errorf("%s is neither a binary tag (%v) nor YAML data (%v)", filename, binErr, yamlErr))
+	}
+	return tag
+}
@@ -31,30 +31,60 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/cjbearman/openprinttag"
 )
 
-var load, out, imprt, setURI string
-var soft, useYaml, optcheck, validate, uuids, root, regions, uri, all,
-	discardAux, hexForm, b64, hexDump, testMode, nocc bool
+var load, out, imprt, setURI, profileName, tagUID string
+var soft, useYaml, useJSON, optcheck, validate, uuids, root, regions, uri, computed, all,
+	discardAux, hexForm, b64, hexDump, testMode, nocc, type2, fitsOn, schema bool
 var initTag, auxSize, metaSize, blockSize int
+var sets, unsets repeatedFlag
+var stirInterval time.Duration
+
+// exitInvalidTag is the exit code when -validate, -opt-check or -all find errors, distinct
+// from the exit code of 1 for failures to process the tag
+const exitInvalidTag = 2
+
+// repeatedFlag collects the values of a flag that may be given more than once
+type repeatedFlag []string
+
+func (r *repeatedFlag) String() string {
+	return strings.Join(*r, ", ")
+}
+
+func (r *repeatedFlag) Set(value string) error {
+	*r = append(*r, value)
+	return nil
+}
 
 func cmdLine() {
 	// Command line
 	flag.StringVar(&load, "load", "", "Loads an existing open print tag from a file (or specify \"-\" to load from STDIN)")
 	flag.StringVar(&out, "out", "", "Outputs the completed tag to a file (or specify \"-\" to output to STDOUT)")
-	flag.StringVar(&imprt, "data", "", "Import YAML encoded data and apply to tag")
+	flag.StringVar(&imprt, "data", "", "Import YAML or JSON encoded data and apply to tag")
+	flag.Var(&sets, "set", "Set a field, as region.field=value (for example main.brand_name=Acme or main.nominal_netto_full_weight=1kg), may be repeated")
+	flag.Var(&unsets, "unset", "Clear a field, as region.field (for example main.gtin), may be repeated")
 	flag.BoolVar(&soft, "soft", false, "When importing data to a tag, do not overwrite fields already set in the tag")
 	flag.BoolVar(&useYaml, "yaml", false, "output as YAML instead of binary tag")
-	flag.BoolVar(&optcheck, "opt-check", false, "Run opt-check, requires -yaml")
-	flag.BoolVar(&validate, "validate", false, "Validate required/recommended fields, requires -yaml")
-	flag.BoolVar(&uuids, "uuids", false, "Output defined/calculated UUIDs, require -yaml")
-	flag.BoolVar(&root, "root", false, "Output root information, requires -yaml")
-	flag.BoolVar(&regions, "regions", false, "Output region information, requires -yaml")
-	flag.BoolVar(&uri, "uri", false, "Output URI information, requires -yaml")
-	flag.BoolVar(&all, "all", false, "Output all possible YAML information, requires -yaml")
+	flag.BoolVar(&useJSON, "json", false, "output as JSON instead of binary tag")
+	flag.BoolVar(&optcheck, "opt-check", false, "Run opt-check, requires -yaml or -json")
+	flag.BoolVar(&validate, "validate", false, "Validate required/recommended fields, requires -yaml or -json")
+	flag.BoolVar(&uuids, "uuids", false, "Output defined/calculated UUIDs, requires -yaml or -json")
+	flag.BoolVar(&root, "root", false, "Output root information, requires -yaml or -json")
+	flag.BoolVar(&regions, "regions", false, "Output region information, requires -yaml or -json")
+	flag.BoolVar(&uri, "uri", false, "Output URI information, requires -yaml or -json")
+	flag.BoolVar(&computed, "computed", false, "Output values computed from the data, such as remaining weight and length, requires -yaml or -json")
+	flag.DurationVar(&stirInterval, "stir-interval", openprinttag.DefaultStirInterval, "Interval after which resin should be stirred again, for -computed")
+	flag.StringVar(&tagUID, "uid", "", "NFC UID of the physical tag in hex, from which instance_uuid is derived, for -uuids and -opt-check")
+	flag.BoolVar(&all, "all", false, "Output all possible YAML/JSON information, requires -yaml or -json")
 	flag.IntVar(&initTag, "init", 0, "Initialize a new tag with the provided size")
+	flag.StringVar(&profileName, "profile", "", "Use a named tag profile, initializing a new tag sized for it unless -load is used. One of: "+profileNames())
+	flag.BoolVar(&fitsOn, "fits-on", false, "Report which tag profiles can hold the tag's data instead of outputting the tag")
 	flag.IntVar(&auxSize, "aux-size", 0, "Set size of aux section")
 	flag.IntVar(&metaSize, "meta-size", 0, "Set size of meta section")
 	flag.IntVar(&blockSize, "block-size", 0, "Set block size")
@@ -65,44 +95,65 @@ func cmdLine() {
 	flag.BoolVar(&hexDump, "hex-dump", false, "Output tag in hex dump format, -out required")
 	flag.BoolVar(&testMode, "test-mode", false, "Sets parameters used for integration test")
 	flag.BoolVar(&nocc, "no-cc", false, "Disable capability container encoding/decoding")
+	flag.BoolVar(&type2, "type2", false, "Use the NFC Forum Type 2 (NTAG21x) layout for encoding/decoding")
+	flag.BoolVar(&schema, "schema", false, "Output the JSON Schema for YAML/JSON data files and exit")
 
 	flag.Parse()
 
-	if optcheck && !useYaml {
-		terminal(errors.New("-opt-check flag requires -yaml flag"))
+	// The schema is static, so needs no tag
+	if schema {
+		writeOutput(out, openprinttag.DataSchema())
+		os.Exit(0)
 	}
-	if validate && !useYaml {
-		terminal(errors.New("-validate flag requires -yaml flag"))
+
+	structured := useYaml || useJSON
+	if optcheck && !structured {
+		terminal(errors.New("-opt-check flag requires -yaml or -json flag"))
+	}
+	if validate && !structured {
+		terminal(errors.New("-validate flag requires -yaml or -json flag"))
+	}
+	if uuids && !structured {
+		terminal(errors.New("-uuids flag requires -yaml or -json flag"))
 	}
-	if uuids && !useYaml {
-		terminal(errors.New("-uuids flag requires -yaml flag"))
+	if root && !structured {
+		terminal(errors.New("-root flag requires -yaml or -json flag"))
 	}
-	if root && !useYaml {
-		terminal(errors.New("-uuids flag requires -yaml flag"))
+	if regions && !structured {
+		terminal(errors.New("-regions flag requires -yaml or -json flag"))
 	}
-	if regions && !useYaml {
-		terminal(errors.New("-uuids flag requires -yaml flag"))
+	if uri && !structured {
+		terminal(errors.New("-uri flag requires -yaml or -json flag"))
 	}
-	if uri && !useYaml {
-		terminal(errors.New("-uuids flag requires -yaml flag"))
+	if computed && !structured {
+		terminal(errors.New("-computed flag requires -yaml or -json flag"))
 	}
-	if all && !useYaml {
-		terminal(errors.New("-all flag requires -yaml flag"))
+	if all && !structured {
+		terminal(errors.New("-all flag requires -yaml or -json flag"))
+	}
+	if useYaml && useJSON {
+		terminal(errors.New("-yaml and -json are mutually exclusive"))
 	}
 	if initTag != 0 && load != "" {
 		terminal(errors.New("-init and -load cannot be used together"))
 	}
-	if initTag == 0 && load == "" {
-		terminal(errors.New("must use either -init or -load"))
+	if initTag != 0 && profileName != "" {
+		terminal(errors.New("-init and -profile cannot be used together"))
+	}
+	if initTag == 0 && load == "" && profileName == "" {
+		terminal(errors.New("must use either -init, -profile or -load"))
+	}
+	if fitsOn && structured {
+		terminal(errors.New("-fits-on cannot be used with -yaml or -json"))
 	}
-	if hexForm && useYaml {
-		terminal(errors.New("-hex flag and -yaml are mutually exclusive"))
+	if hexForm && structured {
+		terminal(errors.New("-hex flag cannot be used with -yaml or -json"))
 	}
-	if hexDump && useYaml {
-		terminal(errors.New("-hex-dump and -yaml are mutually exclusive"))
+	if hexDump && structured {
+		terminal(errors.New("-hex-dump cannot be used with -yaml or -json"))
 	}
-	if b64 && useYaml {
-		terminal(errors.New("-base-64 and -yaml are mutually exclusive"))
+	if b64 && structured {
+		terminal(errors.New("-base-64 cannot be used with -yaml or -json"))
 	}
 	if hexForm && hexDump {
 		terminal(errors.New("-hex and -hex-dump are mutually exclusive"))
@@ -117,6 +168,12 @@ func cmdLine() {
 }
 
 func main() {
+	// Sub-commands are handled separately from the main tag processing flow
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		diffMain(os.Args[2:])
+		os.Exit(0)
+	}
+
 	cmdLine()
 
 	// Set up encode/decode options
@@ -124,17 +181,32 @@ func main() {
 	if nocc {
 		ecOpts = append(ecOpts, openprinttag.WithoutCapabilityContainer)
 	}
+	if type2 {
+		ecOpts = append(ecOpts, openprinttag.Type2Layout)
+	}
+
+	var profile *openprinttag.TagProfile
+	if profileName != "" {
+		found, ok := openprinttag.LookupProfile(profileName)
+		if !ok {
+			terminal(fmt.Errorf("unknown tag profile %q, must be one of: %s", profileName, profileNames()))
+		}
+		profile = &found
+		ecOpts = append(ecOpts, profile.EncodeDecodeOptions()...)
+	}
 
 	// First step we create our working tag, either as new or from file
 	var tag *openprinttag.OpenPrintTag
 	if initTag != 0 {
 		tag = openprinttag.NewOpenPrintTag().WithSize(initTag)
+	} else if load == "" {
+		tag = openprinttag.NewOpenPrintTag().WithProfile(*profile)
 	} else {
 		tagData := loadTag(load)
 		var err error
 		tag, err = openprinttag.Decode(tagData, ecOpts...)
 		if err != nil {
-			terminal(fmt.Errorf("failed to decode tag: %w", err))
+			terminal(decodeFailure(err, tagData))
 		}
 	}
 
@@ -152,6 +224,14 @@ func main() {
 	if blockSize != 0 {
 		tag.WithBlockSize(blockSize)
 	}
+	tag.WithStirInterval(stirInterval)
+	if tagUID != "" {
+		uid, err := hex.DecodeString(tagUID)
+		if err != nil {
+			terminal(fmt.Errorf("invalid -uid: %w", err))
+		}
+		tag.WithTagUID(uid)
+	}
 	if setURI != "" {
 		tag.WithURIRecord(setURI)
 	}
@@ -165,8 +245,26 @@ func main() {
 		tag.Merge(imported, !soft)
 	}
 
+	// Individual field edits, clearing before setting
+	for _, path := range unsets {
+		if err := tag.Unset(path); err != nil {
+			terminal(fmt.Errorf("failed to unset %s: %w", path, err))
+		}
+	}
+	for _, assignment := range sets {
+		path, value, found := strings.Cut(assignment, "=")
+		if !found {
+			terminal(fmt.Errorf("-set requires region.field=value, got %s", assignment))
+		}
+		if err := tag.Set(path, value); err != nil {
+			terminal(fmt.Errorf("failed to set %s: %w", path, err))
+		}
+	}
+
 	// Output stage
-	if useYaml {
+	if fitsOn {
+		writeOutput(out, fitsOnReport(tag, ecOpts))
+	} else if useYaml || useJSON {
 		options := []openprinttag.YAMLOption{}
 		includeIf := func(condition bool, option openprinttag.YAMLOption) {
 			if condition {
@@ -179,12 +277,21 @@ func main() {
 		includeIf(root, openprinttag.IncludeRootStats)
 		includeIf(regions, openprinttag.IncludeRegionStats)
 		includeIf(uri, openprinttag.IncludeURI)
+		includeIf(computed, openprinttag.IncludeComputed)
 		includeIf(all, openprinttag.IncludeAll)
-		yamlData, err := tag.ToYAML(options...)
-		if err != nil {
-			terminal(fmt.Errorf("failed to format tag as YAML: %w", err))
+		if useJSON {
+			jsonData, err := tag.ToJSON(options...)
+			if err != nil {
+				terminal(fmt.Errorf("failed to format tag as JSON: %w", err))
+			}
+			writeOutput(out, []byte(jsonData+"\n"))
+		} else {
+			yamlData, err := tag.ToYAML(options...)
+			if err != nil {
+				terminal(fmt.Errorf("failed to format tag as YAML: %w", err))
+			}
+			writeOutput(out, []byte(yamlData))
 		}
-		writeOutput(out, []byte(yamlData))
 	} else {
 		bintag, err := tag.Encode(ecOpts...)
 		if err != nil {
@@ -192,6 +299,14 @@ func main() {
 		}
 		writeOutput(out, bintag)
 	}
+
+	// The output is still written, so that the errors can be seen
+	if (validate || all) && tag.Validate().HasErrors() {
+		os.Exit(exitInvalidTag)
+	}
+	if (optcheck || all) && tag.OptCheck().HasErrors() {
+		os.Exit(exitInvalidTag)
+	}
 	os.Exit(0)
 }
 
@@ -218,6 +333,16 @@ func loadRecords(filename string) *openprinttag.OpenPrintTag {
 	if err != nil {
 		terminal(fmt.Errorf("failed to read from %s: %w", filename, err))
 	}
+
+	// JSON documents are objects, anything else is treated as YAML
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "{") {
+		tag, err := openprinttag.FromJSON(string(data))
+		if err != nil {
+			terminal(fmt.Errorf("failed to parse json data from %s: %w", filename, err))
+		}
+		return tag
+	}
+
 	tag, err := openprinttag.FromYAML(string(data))
 	if err != nil {
 		terminal(fmt.Errorf("failed to parse yaml data from %s: %w", filename, err))
@@ -266,6 +391,53 @@ func writeOutput(filename string, output []byte) []byte {
 	return nil
 }
 
+// profileNames lists the names of the registered tag profiles
+func profileNames() string {
+	names := []string{}
+	for _, profile := range openprinttag.Profiles() {
+		names = append(names, profile.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// fitsOnReport encodes the tag on each tag profile and reports which could hold its data
+func fitsOnReport(tag *openprinttag.OpenPrintTag, ecOpts []openprinttag.EncodeDecodeOption) []byte {
+	// Each profile brings its own layout
+	opts := slices.DeleteFunc(slices.Clone(ecOpts), func(opt openprinttag.EncodeDecodeOption) bool {
+		return opt == openprinttag.Type2Layout
+	})
+	fitsOn, err := tag.FitsOn(opts...)
+	if err != nil {
+		terminal(fmt.Errorf("failed to encode tag: %w", err))
+	}
+
+	var report bytes.Buffer
+	writer := tabwriter.NewWriter(&report, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(writer, "PROFILE\tTAG\tSIZE\tREQUIRED\tFITS\n")
+	for _, fit := range fitsOn {
+		fits := "no"
+		if fit.Fits {
+			fits = "yes"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%d\t%d\t%s\n", fit.Profile.Name, fit.Profile.Description, fit.Profile.Size, fit.Required, fits)
+	}
+	_ = writer.Flush()
+	return report.Bytes()
+}
+
+// decodeFailure describes a failure to decode, showing the bytes around the failing
+// offset where the decoder was able to identify one
+func decodeFailure(err error, tagData []byte) error {
+	var decodeErr *openprinttag.DecodeError
+	if !errors.As(err, &decodeErr) || decodeErr.Offset < 0 || decodeErr.Offset >= len(tagData) {
+		return fmt.Errorf("failed to decode tag: %w", err)
+	}
+	lineStart := decodeErr.Offset &^ 0x0f
+	lineEnd := min(lineStart+16, len(tagData))
+	return fmt.Errorf("failed to decode tag: %w\n%s layer error at byte offset %d (0x%04x)\n%04x: % x",
+		err, decodeErr.Layer, decodeErr.Offset, decodeErr.Offset, lineStart, tagData[lineStart:lineEnd])
+}
+
 // terminal will receive an error and if not nil will output the error to stderr
 // and exit with code 1
 func terminal(err error) {
@@ -24,7 +24,6 @@ package openprinttag
 import (
 	"bytes"
 	"errors"
-	"fmt"
 	"io"
 	"reflect"
 	"slices"
@@ -67,24 +66,50 @@ func decode(tagData []byte, opts ...EncodeDecodeOption) (*OpenPrintTag, error) {
 	// We will use a reader to stream the bytes
 	data := bytes.NewReader(tagData)
 
+	// We retain what we can of the original layout, so an unmodified tag re-encodes identically
+	layout := &tagLayout{size: len(tagData)}
+
 	// Check capability container
 
 	if !slices.Contains(opts, WithoutCapabilityContainer) {
 		cc := make([]byte, 4)
 		n, err := data.Read(cc)
-		assertTrue(err == nil && n == 4, "Failed to read 4 byte cc, read: %d, err: %v", n, err)
-		assertTrue(cc[0] == 0xe1, "Capability container magic number does not match")
+		assertDecode(err == nil && n == 4, ReasonTruncated, LayerCC, n, "failed to read 4 byte cc, read: %d", n)
+		assertDecode(cc[0] == 0xe1 || cc[0] == 0xe2, ReasonInvalidCapabilityContainer, LayerCC, 0, "capability container magic number 0x%02x does not match", cc[0])
+		if !slices.Contains(opts, Type2Layout) && cc[2] == 0x00 {
+			// A zero size indicates the 8 byte CC, with the size (MLEN) in the last two bytes
+			extended := make([]byte, extendedCCSize-4)
+			n, err := data.Read(extended)
+			assertDecode(err == nil && n == len(extended), ReasonTruncated, LayerCC, 4+n, "failed to read 8 byte cc, read: %d", 4+n)
+			cc = append(cc, extended...)
+		} else if slices.Contains(opts, Type2Layout) {
+			assertDecode(cc[1]>>4 == 1, ReasonInvalidCapabilityContainer, LayerCC, 1, "unsupported Type 2 mapping version 0x%02x", cc[1])
+			dataAreaSize := int(cc[2]) * 8
+			assertDecode(len(tagData) >= type2CCSize+dataAreaSize, ReasonTruncated, LayerCC, 2,
+				"capability container declares a %d byte data area, but only %d bytes follow it", dataAreaSize, len(tagData)-type2CCSize)
+		}
+		layout.capabilityContainer = cc
 	}
 
 	// Find NDEF TLV
+	tlvStart := len(tagData) - data.Len()
+	var ndefTLVStart, ndefValueLength int
 	for {
+		ndefTLVStart = len(tagData) - data.Len()
 		baseTLV := make([]byte, 2)
 		n, err := data.Read(baseTLV)
 
 		// Either gone out of range or hit a terminator TLV
 		tag := baseTLV[0]
 		if (err != nil || n != 2) || tag == 0xFE {
-			return nil, errors.New("did not find NDEF TLV")
+			failDecode(ReasonNoNDEFTLV, LayerTLV, ndefTLVStart, nil, "did not find NDEF TLV")
+		}
+
+		// NULL TLVs (padding, common on Type 2 tags) have no length field
+		if tag == 0x00 {
+			_, err = data.Seek(-1, io.SeekCurrent)
+			assertDecode(err == nil, ReasonTruncated, LayerTLV, ndefTLVStart, "failed NULL TLV skip")
+			continue
 		}
 
 		TLVLen := int64(baseTLV[1])
@@ -93,71 +118,94 @@ func decode(tagData []byte, opts ...EncodeDecodeOption) (*OpenPrintTag, error) {
 		if TLVLen == 0xFF {
 			extLenBuf := make([]byte, 2)
 			n, err := data.Read(extLenBuf)
-			assertTrue(err == nil && n == 2, "Failed to read extended length")
+			assertDecode(err == nil && n == 2, ReasonTruncated, LayerTLV, ndefTLVStart+2, "failed to read extended TLV length")
 			TLVLen = int64(extLenBuf[0])*256 + int64(extLenBuf[1])
 		}
 
 		if tag == 0x03 {
 			// 0x03 = NDEF TLV, found it
+			ndefValueLength = int(TLVLen)
 			break
 		}
 		// Skip this TLV block
 		_, err = data.Seek(TLVLen, io.SeekCurrent)
-		assertTrue(err == nil, "failed TLV block skip")
+		assertDecode(err == nil, ReasonTruncated, LayerTLV, ndefTLVStart, "failed TLV block skip")
 	}
 
 	// Everything that is left should be the NDEF content
 	// read it all into remainingBytes
+	ndefStart := len(tagData) - data.Len()
 	remainingLen := data.Len()
-	assertTrue(remainingLen > 0, "no NDEF records found")
+	assertDecode(remainingLen > 0, ReasonTruncated, LayerNDEF, ndefStart, "no NDEF records found")
 	remainingBytes := make([]byte, remainingLen)
 	n, err := data.Read(remainingBytes)
-	assertTrue(err == nil && n == remainingLen, "Failed to read NDEF records")
+	assertDecode(err == nil && n == remainingLen, ReasonTruncated, LayerNDEF, ndefStart, "failed to read NDEF records")
+
+	layout.preambleTLVs = bytes.Clone(tagData[tlvStart:ndefTLVStart])
+	if ndefValueLength <= remainingLen {
+		layout.trailer = bytes.Clone(remainingBytes[ndefValueLength:])
+	}
 
 	msg := ndef.Message{}
 	_, err = msg.Unmarshal(remainingBytes)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal NDEF message: %w", err)
+		failDecode(ReasonInvalidNDEF, LayerNDEF, ndefStart, err, "failed to unmarshal NDEF message")
 	}
 
 	// There may be multiple records, got to process them all
-	var uriRecord, optRecord *ndef.Record
+	// tracking where each starts, so that errors can be reported against absolute offsets
+	// All records other than ours are retained, in order, so that they survive re-encoding
+	var optRecord *ndef.Record
+	recordStart, optRecordStart := ndefStart, ndefStart
 	for _, rec := range msg.Records {
-
-		// Type U is a URI record, which we need to capture
-		if rec.Type() == "U" {
-			uriRecord = rec
-		}
+		recordBytes, err := rec.Marshal()
+		assertDecode(err == nil, ReasonInvalidNDEF, LayerNDEF, recordStart, "failed to measure NDEF record")
 
 		// If the type is our mime type, it's the OPT CBOR data
-		if rec.Type() == mimeType {
+		if rec.Type() == mimeType && optRecord == nil {
 			optRecord = rec
+			optRecordStart = recordStart
+		} else {
+			record, err := newNDEFRecord(rec)
+			if err != nil {
+				failDecode(ReasonInvalidNDEF, LayerNDEF, recordStart, err, "failed to get payload from %s record", rec.Type())
+			}
+			opt.records = append(opt.records, record)
+			if optRecord == nil {
+				opt.recordsBefore++
+			}
 		}
+		recordStart += len(recordBytes)
 	}
 
 	// If we didn't find our mime type (opt) record, it's game over
-	assertTrue(optRecord != nil, "Did not find an open print tag record")
-
-	// If there is a URI record, propagate it into the finalized open print tag
-	if uriRecord != nil {
-		payload, err := uriRecord.Payload()
-		assertTrue(err == nil, "failed to get payload from URI record")
-		opt.WithURIRecord(payload.String())
-	}
+	assertDecode(optRecord != nil, ReasonNoOpenPrintTagRecord, LayerNDEF, ndefStart, "did not find an open print tag record")
 
 	// Get the raw byte content from the OPT record, this is the data containing the CBOR regions
 	optDataPayload, err := optRecord.Payload()
-	assertTrue(err == nil, "failed to get payload from open print tag record")
+	assertDecode(err == nil, ReasonInvalidNDEF, LayerNDEF, optRecordStart, "failed to get payload from open print tag record")
 	odp, ok := optDataPayload.(*media.Payload)
-	assertTrue(ok, "incorrect media payload type")
+	assertDecode(ok, ReasonInvalidNDEF, LayerNDEF, optRecordStart, "incorrect media payload type")
 	optPayload := odp.Payload
+	layout.payload = bytes.Clone(optPayload)
+
+	// The payload is the tail of the record
+	optRecordBytes, _ := optRecord.Marshal()
+	payloadStart := optRecordStart + len(optRecordBytes) - len(optPayload)
 
 	// The meta region is first
 	meta := metaInternal{}
 	rest, err := cbor.UnmarshalFirst(optPayload, &meta)
-	assertTrue(err == nil, "failed to decode meta region: %v", err)
-	opt.meta = &MetaRegion{internal: meta}
+	if err != nil {
+		failDecode(ReasonInvalidRegion, LayerMeta, payloadStart, err, "failed to decode meta region")
+	}
+	opt.meta = newMetaRegion()
+	opt.meta.internal = meta
 	opt.meta.internal.Unknowns, _ = getUnknownFields(opt.meta.internal, optPayload)
+	err = decodeRegionLayout(optPayload, floatFieldKeys(&opt.meta.internal), opt.meta.regionOptions)
+	if err != nil {
+		failDecode(ReasonInvalidRegion, LayerMeta, payloadStart, err, "failed to decode meta region layout")
+	}
 
 	// If our meta region doesn't have a main offset, it's immediately following meta
 	// Since the unmarshaller returns the remaining bytes, we can calculate the offset
@@ -167,6 +215,9 @@ func decode(tagData []byte, opts ...EncodeDecodeOption) (*OpenPrintTag, error) {
 	// Check for offsets in the meta
 	if meta.MainRegionOffset != nil {
 		mainRegionOffset = *meta.MainRegionOffset
+
+		// An explicit main region offset means the meta region was given a fixed size
+		opt.metaRegionSize = mainRegionOffset
 	}
 
 	var auxRegionOffset int
@@ -174,42 +225,53 @@ func decode(tagData []byte, opts ...EncodeDecodeOption) (*OpenPrintTag, error) {
 		auxRegionOffset = *meta.AuxRegionOffset
 	}
 
+	assertDecode(mainRegionOffset >= 0 && mainRegionOffset < len(optPayload), ReasonInvalidRegionOffset, LayerMeta, payloadStart,
+		"main region offset %d lies outside of the %d byte payload", mainRegionOffset, len(optPayload))
+	assertDecode(auxRegionOffset >= 0 && auxRegionOffset < len(optPayload), ReasonInvalidRegionOffset, LayerMeta, payloadStart,
+		"aux region offset %d lies outside of the %d byte payload", auxRegionOffset, len(optPayload))
+
 	// Load main region
 	main := mainInternal{}
 	_, err = cbor.UnmarshalFirst(optPayload[mainRegionOffset:], &main)
-	assertTrue(err == nil, "invalid main region")
-	opt.main = &MainRegion{internal: main}
+	if err != nil {
+		failDecode(ReasonInvalidRegion, LayerMain, payloadStart+mainRegionOffset, err, "invalid main region")
+	}
+	opt.main = newMainRegion()
+	opt.main.internal = main
 
 	opt.main.internal.Unknowns, _ = getUnknownFields(opt.main.internal, optPayload[mainRegionOffset:])
+	err = decodeRegionLayout(optPayload[mainRegionOffset:], floatFieldKeys(&opt.main.internal), opt.main.regionOptions)
+	if err != nil {
+		failDecode(ReasonInvalidRegion, LayerMain, payloadStart+mainRegionOffset, err, "failed to decode main region layout")
+	}
 
 	// If there is no aux region offset, there is no aux region (by spec)
 	// load it if we have the offset
 	if auxRegionOffset != 0 {
 		aux := auxInternal{}
 		_, err = cbor.UnmarshalFirst(optPayload[auxRegionOffset:], &aux)
-		assertTrue(err == nil, "failed to read aux region")
+		if err != nil {
+			failDecode(ReasonInvalidRegion, LayerAux, payloadStart+auxRegionOffset, err, "failed to read aux region")
+		}
 		// Got an aux region
-		opt.aux = &AuxRegion{internal: aux}
+		opt.aux = newAuxRegion()
+		opt.aux.internal = aux
 		opt.aux.internal.Unknowns, _ = getUnknownFields(opt.aux.internal, optPayload[auxRegionOffset:])
+		err = decodeRegionLayout(optPayload[auxRegionOffset:], floatFieldKeys(&opt.aux.internal), opt.aux.regionOptions)
+		if err != nil {
+			failDecode(ReasonInvalidRegion, LayerAux, payloadStart+auxRegionOffset, err, "failed to decode aux region layout")
+		}
 
 		// The aux region size is basically all bytes in the tag from the aux region offset
 		// with a -3 offset for the NDEF structures
 		opt.auxRegionSize = len(optPayload[auxRegionOffset:]) - 3
 	} // if there is no aux region offset in meta, it is not present
 
-	if opt.meta != nil {
-		opt.meta.regionOptions = defaultMetaOptions
-	}
-	if opt.main != nil {
-		opt.main.regionOptions = defaultMainOptions
-	}
-	if opt.aux != nil {
-		opt.aux.regionOptions = defaultAuxOptions
-	}
+	opt.layout = layout
 
 	// Done.
-	// N.B. the blockSize setting may be incorrect, but if we have aux we have an aux region offset
-	// already, so this is somewhat irrelevant
+	// N.B. the blockSize cannot be determined from the tag, but if we have aux we have an aux region
+	// offset already, which retains the original alignment
 	return opt, nil
 }
 
@@ -0,0 +1,212 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+
+	st "github.com/cjbearman/openprinttag/structtags"
+)
+
+// FieldChange describes a single field that differs between two tags
+type FieldChange struct {
+	// Name is the native (snake case) field name, or the formatted key for unknown fields
+	Name string `yaml:"name" json:"name"`
+
+	// Key is the CBOR key of the field
+	Key any `yaml:"key" json:"key"`
+
+	// Unknown is true where the field is not defined by the specification (including vendor fields)
+	Unknown bool `yaml:"unknown,omitempty" json:"unknown,omitempty"`
+
+	// From is the value in the first tag, nil for added fields
+	From any `yaml:"from,omitempty" json:"from,omitempty"`
+
+	// To is the value in the second tag, nil for removed fields
+	To any `yaml:"to,omitempty" json:"to,omitempty"`
+}
+
+// RegionDiff lists the fields that were added, removed or changed within one region
+type RegionDiff struct {
+	Added   []FieldChange `yaml:"added,omitempty" json:"added,omitempty"`
+	Removed []FieldChange `yaml:"removed,omitempty" json:"removed,omitempty"`
+	Changed []FieldChange `yaml:"changed,omitempty" json:"changed,omitempty"`
+}
+
+// IsEmpty returns true if there are no differences in the region
+func (r *RegionDiff) IsEmpty() bool {
+	return r == nil || (len(r.Added) == 0 && len(r.Removed) == 0 && len(r.Changed) == 0)
+}
+
+// TagDiff is the field by field difference between two tags
+// Regions without differences are nil
+type TagDiff struct {
+	Meta *RegionDiff `yaml:"meta,omitempty" json:"meta,omitempty"`
+	Main *RegionDiff `yaml:"main,omitempty" json:"main,omitempty"`
+	Aux  *RegionDiff `yaml:"aux,omitempty" json:"aux,omitempty"`
+}
+
+// IsEmpty returns true if the diff contains no differences
+func (d *TagDiff) IsEmpty() bool {
+	return d.Meta.IsEmpty() && d.Main.IsEmpty() && d.Aux.IsEmpty()
+}
+
+// Diff compares the data held in two tags and returns the differences, expressed
+// as changes required to turn tag a into tag b
+// Only field data is compared, not sizes or encoding options
+func Diff(a, b *OpenPrintTag) *TagDiff {
+	d := &TagDiff{}
+
+	var aMeta, bMeta, aMain, bMain, aAux, bAux any
+	if a.meta != nil {
+		aMeta = &a.meta.internal
+	}
+	if b.meta != nil {
+		bMeta = &b.meta.internal
+	}
+	if a.main != nil {
+		aMain = &a.main.internal
+	}
+	if b.main != nil {
+		bMain = &b.main.internal
+	}
+	if a.aux != nil {
+		aAux = &a.aux.internal
+	}
+	if b.aux != nil {
+		bAux = &b.aux.internal
+	}
+
+	d.Meta = diffRegion(reflect.TypeOf(metaInternal{}), aMeta, bMeta)
+	d.Main = diffRegion(reflect.TypeOf(mainInternal{}), aMain, bMain)
+	d.Aux = diffRegion(reflect.TypeOf(auxInternal{}), aAux, bAux)
+	return d
+}
+
+// Equal returns true if the two tags hold identical field data
+func Equal(a, b *OpenPrintTag) bool {
+	return Diff(a, b).IsEmpty()
+}
+
+// diffRegion compares two region internal structs of the given type, either of which
+// may be nil (absent region), returning nil if they are identical
+func diffRegion(internalType reflect.Type, a, b any) *RegionDiff {
+	// An absent region is treated as an empty one
+	aValue := reflect.New(internalType).Elem()
+	if a != nil {
+		aValue = reflect.ValueOf(a).Elem()
+	}
+	bValue := reflect.New(internalType).Elem()
+	if b != nil {
+		bValue = reflect.ValueOf(b).Elem()
+	}
+
+	rd := &RegionDiff{}
+	for i := 0; i < internalType.NumField(); i++ {
+		tag := internalType.Field(i).Tag.Get(st.OptTag)
+		if tag == "" {
+			continue
+		}
+		tagMap := decodeOptTag(tag)
+		change := FieldChange{Name: tagMap[st.OptTagName]}
+		if key, err := strconv.Atoi(tagMap[st.OptTagKey]); err == nil {
+			change.Key = key
+		}
+
+		aField := aValue.Field(i)
+		bField := bValue.Field(i)
+		switch {
+		case aField.IsNil() && bField.IsNil():
+			continue
+		case aField.IsNil():
+			change.To = bField.Elem().Interface()
+			rd.Added = append(rd.Added, change)
+		case bField.IsNil():
+			change.From = aField.Elem().Interface()
+			rd.Removed = append(rd.Removed, change)
+		case !reflect.DeepEqual(aField.Elem().Interface(), bField.Elem().Interface()):
+			change.From = aField.Elem().Interface()
+			change.To = bField.Elem().Interface()
+			rd.Changed = append(rd.Changed, change)
+		}
+	}
+
+	// Finally the unknown fields, which live in a map
+	aUnknowns, _ := aValue.FieldByName("Unknowns").Interface().(map[any]any)
+	bUnknowns, _ := bValue.FieldByName("Unknowns").Interface().(map[any]any)
+	unknownChange := func(key any) FieldChange {
+		return FieldChange{Name: fmt.Sprintf("%v", key), Key: key, Unknown: true}
+	}
+	for _, key := range sortedUnknownKeys(aUnknowns) {
+		aItem := aUnknowns[key]
+		bItem, found := bUnknowns[key]
+		change := unknownChange(key)
+		if !found {
+			change.From = aItem
+			rd.Removed = append(rd.Removed, change)
+		} else if !reflect.DeepEqual(aItem, bItem) {
+			change.From = aItem
+			change.To = bItem
+			rd.Changed = append(rd.Changed, change)
+		}
+	}
+	for _, key := range sortedUnknownKeys(bUnknowns) {
+		if _, found := aUnknowns[key]; !found {
+			change := unknownChange(key)
+			change.To = bUnknowns[key]
+			rd.Added = append(rd.Added, change)
+		}
+	}
+
+	if rd.IsEmpty() {
+		return nil
+	}
+	return rd
+}
+
+// sortedUnknownKeys returns the keys of an unknown field map in a stable order
+// integer keys first, numerically, followed by anything else by representation
+func sortedUnknownKeys(unknowns map[any]any) []any {
+	keys := make([]any, 0, len(unknowns))
+	for key := range unknowns {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b any) int {
+		aInt, aIsInt := a.(uint64)
+		bInt, bIsInt := b.(uint64)
+		switch {
+		case aIsInt && bIsInt:
+			return cmp.Compare(aInt, bInt)
+		case aIsInt:
+			return -1
+		case bIsInt:
+			return 1
+		default:
+			return cmp.Compare(fmt.Sprintf("%v", a), fmt.Sprintf("%v", b))
+		}
+	})
+	return keys
+}
@@ -60,10 +60,28 @@ func (o *OpenPrintTag) Encode(opts ...EncodeDecodeOption) (result []byte, err er
 // those panics are caught and transformed to errors in the main Encode
 // function
 func (o *OpenPrintTag) encode(opts ...EncodeDecodeOption) ([]byte, error) {
+	type2 := slices.Contains(opts, Type2Layout)
+
+	// The CC of a Type 2 tag describes only the data area that follows it
+	ccDescribedSize := o.size
+	if type2 {
+		ccDescribedSize = o.size - type2CCSize
+	}
+
+	// NFC-V tags beyond 2040 bytes need the 8 byte CC, which describes the memory following it
+	extendedCC := !type2 && o.size/8 > 255
+	if extendedCC {
+		ccDescribedSize = o.size - extendedCCSize
+	}
+
 	if !slices.Contains(opts, WithoutCapabilityContainer) {
 		// If we are not encoding a capability container, these checks are irrelevant
-		assertTrue((o.size%8) == 0, "Tag size %d must be divisible by 8 (to be encodable in the CC)", o.size)
-		assertTrue((o.size/8) <= 255, "Tag too big to be representable in the CC")
+		assertEncode((ccDescribedSize%8) == 0, ReasonInvalidTagSize, LayerCC, -1, "Tag size %d must be divisible by 8 (to be encodable in the CC)", ccDescribedSize)
+		if extendedCC {
+			assertEncode((ccDescribedSize/8) <= 0xFFFF, ReasonInvalidTagSize, LayerCC, -1, "Tag too big to be representable in the CC")
+		} else {
+			assertEncode((ccDescribedSize/8) <= 255, ReasonInvalidTagSize, LayerCC, -1, "Tag too big to be representable in the CC")
+		}
 	}
 
 	assertTrue(o.blockSize > 0, "Block size must be >0")
@@ -74,13 +92,47 @@ func (o *OpenPrintTag) encode(opts ...EncodeDecodeOption) ([]byte, error) {
 		byte(o.size / 8), // Size
 		0x01,             // MBREAD
 	}
+	if extendedCC {
+		capabilityContainer = []byte{
+			0xE2,                             // Magic Number (2 byte addressing)
+			0x40,                             // Version
+			0x00,                             // Size, zero indicates MLEN follows
+			0x01,                             // MBREAD
+			0x00,                             // RFU
+			0x00,                             // RFU
+			byte((ccDescribedSize / 8) >> 8), // MLEN
+			byte(ccDescribedSize / 8),
+		}
+	}
+
+	// Type 2 tags with more than the static memory declare their dynamic lock bytes
+	var preambleTLVs []byte
+	if type2 {
+		capabilityContainer = type2CapabilityContainer(ccDescribedSize)
+		preambleTLVs = type2LockControlTLV(ccDescribedSize)
+	}
+
+	// Where this tag was decoded, and has not been resized, we retain the original layout
+	// so that an unmodified tag will be re-encoded identically
+	var layout *tagLayout
+	if o.layout != nil && o.layout.size == o.size {
+		layout = o.layout
+	}
+
+	if layout != nil {
+		preambleTLVs = layout.preambleTLVs
+		if len(layout.capabilityContainer) == len(capabilityContainer) && layout.capabilityContainer[2] == capabilityContainer[2] &&
+			slices.Equal(layout.capabilityContainer[4:], capabilityContainer[4:]) {
+			capabilityContainer = layout.capabilityContainer
+		}
+	}
 
 	capabilityContainerSize := len(capabilityContainer)
 	TLVTerminator := []byte{0xFE}
 	ndefTLVHeaderSize := 2
 
 	// Our NDEF record will be adjusted so that the message fills the whole available space
-	ndefMessageLength := o.size - capabilityContainerSize - len(TLVTerminator) - ndefTLVHeaderSize
+	ndefMessageLength := o.size - capabilityContainerSize - len(preambleTLVs) - len(TLVTerminator) - ndefTLVHeaderSize
 
 	if ndefMessageLength > 0xFE {
 		// We need two more bytes to encode longer TLV lengths
@@ -104,25 +156,27 @@ func (o *OpenPrintTag) encode(opts ...EncodeDecodeOption) ([]byte, error) {
 		}
 	}
 
+	assertEncode(ndefMessageLength <= 0xFFFE, ReasonInvalidTagSize, LayerTLV, capabilityContainerSize+len(preambleTLVs),
+		"NDEF message of %d bytes is too long to be represented in a TLV", ndefMessageLength)
 	assertTrue(len(ndefTLVHeader) == ndefTLVHeaderSize, "length of ndef TLV header not as expected, expected: %d, actual: %d", ndefTLVHeaderSize, len(ndefTLVHeader))
 
-	// Set up preceeding NDEF regions
-	var records = []*ndef.Record{}
-	preceedingRecordsSize := 0
-	if o.uri != "" {
-		records = append(records, ndef.NewURIRecord(o.uri))
-		preceedingRecords, err := ndef.NewMessageFromRecords(records...).Marshal()
-		if err != nil {
-			return nil, fmt.Errorf("failed to encode preceeding records: %w", err)
-		}
-		preceedingRecordsSize = len(preceedingRecords)
+	// Set up the NDEF records that surround ours, which take space from the payload
+	preceedingRecords := o.records[:o.recordsBefore]
+	followingRecords := o.records[o.recordsBefore:]
+	preceedingRecordsSize, err := marshalRecords(preceedingRecords)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode preceeding records: %w", err)
+	}
+	followingRecordsSize, err := marshalRecords(followingRecords)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode following records: %w", err)
 	}
 
 	ndefHeaderSize := 3 + len(mimeType)
-	ndefPayloadStart := capabilityContainerSize + ndefTLVHeaderSize + preceedingRecordsSize + ndefHeaderSize
-	payloadSize := ndefMessageLength - ndefHeaderSize - preceedingRecordsSize
+	ndefPayloadStart := capabilityContainerSize + len(preambleTLVs) + ndefTLVHeaderSize + preceedingRecordsSize + ndefHeaderSize
+	payloadSize := ndefMessageLength - ndefHeaderSize - preceedingRecordsSize - followingRecordsSize
 
-	assertTrue(payloadSize > maxMetaRegionSize, "there is not enough space even for the meta region")
+	assertEncode(payloadSize > maxMetaRegionSize, ReasonInsufficientSpace, LayerNDEF, ndefPayloadStart, "there is not enough space even for the meta region")
 
 	// If the NDEF payload size would exceed 255 bytes, its length cannot be stored in a single byte
 	// and NDEF switches to storing the length into 4 bytes
@@ -135,6 +189,10 @@ func (o *OpenPrintTag) encode(opts ...EncodeDecodeOption) ([]byte, error) {
 	}
 
 	payload := make([]byte, payloadSize)
+	if layout != nil && len(layout.payload) == payloadSize {
+		// Unused space retains its original content
+		copy(payload, layout.payload)
+	}
 
 	writeSection := func(offset int, data []byte) int {
 
@@ -167,7 +225,7 @@ func (o *OpenPrintTag) encode(opts ...EncodeDecodeOption) ([]byte, error) {
 	var auxRegionSizeForStats int
 	var auxEncoded []byte
 	if o.aux != nil {
-		assertTrue(o.auxRegionSize > 4, "Aux region is too small")
+		assertEncode(o.auxRegionSize > 4, ReasonInsufficientSpace, LayerAux, -1, "Aux region is too small")
 
 		var auxRegionOffsetKnown bool
 		auxRegionOffset, auxRegionOffsetKnown = o.meta.GetAuxRegionOffset()
@@ -180,6 +238,8 @@ func (o *OpenPrintTag) encode(opts ...EncodeDecodeOption) ([]byte, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to encode aux region: %w", err)
 		}
+		assertEncode(auxRegionOffset >= 0 && auxRegionOffset+len(auxEncoded) <= payloadSize, ReasonInsufficientSpace, LayerAux, ndefPayloadStart+auxRegionOffset,
+			"aux region of %d bytes does not fit at payload offset %d", len(auxEncoded), auxRegionOffset)
 		writeSection(auxRegionOffset, auxEncoded)
 		auxRegionSizeForStats = len(auxEncoded)
 	}
@@ -189,6 +249,8 @@ func (o *OpenPrintTag) encode(opts ...EncodeDecodeOption) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode meta region: %w", err)
 	}
+	assertEncode(len(metaEncoded) <= payloadSize && (o.metaRegionSize == 0 || len(metaEncoded) <= o.metaRegionSize), ReasonInsufficientSpace, LayerMeta, ndefPayloadStart,
+		"meta region of %d bytes does not fit in the space available", len(metaEncoded))
 	metaSectionSize := writeSection(0, metaEncoded)
 
 	// Prepare meta section
@@ -198,9 +260,9 @@ func (o *OpenPrintTag) encode(opts ...EncodeDecodeOption) ([]byte, error) {
 	}
 
 	if o.auxRegionSize != 0 {
-		assertTrue(auxRegionOffset-mainRegionOffset >= 4, "Main region is too small")
+		assertEncode(auxRegionOffset-mainRegionOffset >= 4, ReasonInsufficientSpace, LayerMain, ndefPayloadStart+mainRegionOffset, "Main region is too small")
 	} else {
-		assertTrue(payloadSize-mainRegionOffset >= 8, "Main region is too small")
+		assertEncode(payloadSize-mainRegionOffset >= 8, ReasonInsufficientSpace, LayerMain, ndefPayloadStart+mainRegionOffset, "Main region is too small")
 	}
 
 	// Write the main section
@@ -208,10 +270,23 @@ func (o *OpenPrintTag) encode(opts ...EncodeDecodeOption) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode main region: %w", err)
 	}
+	mainRegionEnd := payloadSize
+	if o.aux != nil {
+		mainRegionEnd = auxRegionOffset
+	}
+	assertEncode(mainRegionOffset+len(mainEncoded) <= mainRegionEnd, ReasonInsufficientSpace, LayerMain, ndefPayloadStart+mainRegionOffset,
+		"main region of %d bytes exceeds the %d bytes available", len(mainEncoded), mainRegionEnd-mainRegionOffset)
 	writeSection(mainRegionOffset, mainEncoded)
 
-	// Create NDEF record
-	records = append(records, ndef.NewRecord(2, mimeType, "", &generic.Payload{Payload: payload}))
+	// Create NDEF record, in its place amongst the others
+	var records = []*ndef.Record{}
+	for _, record := range preceedingRecords {
+		records = append(records, record.toNDEF())
+	}
+	records = append(records, ndef.NewRecord(TNFMedia, mimeType, "", &generic.Payload{Payload: payload}))
+	for _, record := range followingRecords {
+		records = append(records, record.toNDEF())
+	}
 	// ndefData := []byte{}
 	ndefMsg := ndef.NewMessageFromRecords(records...)
 	ndefData, err := ndefMsg.Marshal()
@@ -222,16 +297,23 @@ func (o *OpenPrintTag) encode(opts ...EncodeDecodeOption) ([]byte, error) {
 	assertTrue(len(ndefData) == ndefMessageLength, "ndef message not expected length. Expected: %d, Actual: %d", ndefMessageLength, len(ndefData))
 
 	// Check that we have deduced the ndef header size correctly
-	expectedSize := preceedingRecordsSize + ndefHeaderSize + payloadSize
+	expectedSize := preceedingRecordsSize + ndefHeaderSize + payloadSize + followingRecordsSize
 	if len(ndefData) != expectedSize {
-		return nil, fmt.Errorf("NDEF record calculated incorrectly: expected size %d, (%d + %d + %d), but got %d", expectedSize, preceedingRecordsSize, ndefHeaderSize, payloadSize, len(ndefData))
+		return nil, fmt.Errorf("NDEF record calculated incorrectly: expected size %d, (%d + %d + %d + %d), but got %d", expectedSize, preceedingRecordsSize, ndefHeaderSize, payloadSize, followingRecordsSize, len(ndefData))
 	}
 
 	fullData := []byte{}
 	fullData = append(fullData, capabilityContainer...)
+	fullData = append(fullData, preambleTLVs...)
 	fullData = append(fullData, ndefTLVHeader...)
 	fullData = append(fullData, ndefData...)
-	fullData = append(fullData, TLVTerminator...)
+
+	// The original terminator, and anything following it, is retained if it still fits
+	if layout != nil && len(layout.trailer) > 0 && layout.trailer[0] == TLVTerminator[0] && len(fullData)+len(layout.trailer) <= o.size {
+		fullData = append(fullData, layout.trailer...)
+	} else {
+		fullData = append(fullData, TLVTerminator...)
+	}
 
 	// The full data can be slightly smaller because we might have decreased ndef_tlv_available_space by 2
 	// to fit the bigger TLV header and then ended up not needing the bigger TLV header
@@ -247,6 +329,9 @@ func (o *OpenPrintTag) encode(opts ...EncodeDecodeOption) ([]byte, error) {
 	o.stats.Root.DataSize = o.size
 	o.stats.Root.PayloadSize = payloadSize
 	o.stats.Root.Overhead = o.size - payloadSize
+	o.stats.Root.CapabilityContainerSize = capabilityContainerSize
+	o.stats.Root.TLVOverhead = len(preambleTLVs) + ndefTLVHeaderSize + len(TLVTerminator)
+	o.stats.Root.NDEFOverhead = preceedingRecordsSize + ndefHeaderSize + followingRecordsSize
 	o.stats.Root.PayloadUsedSize = len(metaEncoded) + len(mainEncoded) + auxRegionSizeForStats
 	o.stats.Root.TotalUsedSize = o.stats.Root.PayloadUsedSize + o.stats.Root.Overhead
 
@@ -276,7 +361,7 @@ func (o *OpenPrintTag) encode(opts ...EncodeDecodeOption) ([]byte, error) {
 
 	// Strip the CC header if requested
 	if slices.Contains(opts, WithoutCapabilityContainer) {
-		fullData = fullData[4:]
+		fullData = fullData[capabilityContainerSize:]
 	}
 
 	return fullData, nil
@@ -0,0 +1,92 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// EnumInfo describes an enumerated value as defined by the specification
+type EnumInfo struct {
+	// Key is the numeric value encoded on the tag
+	Key uint64
+
+	// Name is the specification name, for example "Polylactic Acid" or "abrasive"
+	Name string
+
+	// Abbreviation is the short form, where there is one, for example "PLA"
+	Abbreviation string
+
+	// Description is the specification description, where there is one
+	Description string
+
+	// Deprecated values can still be read and written, but should no longer be used
+	Deprecated bool
+
+	// ReplacedBy names the value replacing a deprecated value, where the specification gives one
+	ReplacedBy string
+}
+
+// lookupEnumInfo finds the information for a key within an enumeration table
+func lookupEnumInfo(infos []EnumInfo, key uint64) (EnumInfo, bool) {
+	for _, info := range infos {
+		if info.Key == key {
+			return info, true
+		}
+	}
+	return EnumInfo{}, false
+}
+
+// parseEnum finds the key for a value within an enumeration table by name, abbreviation
+// or numeric key, ignoring case
+func parseEnum(infos []EnumInfo, str string) (uint64, error) {
+	str = strings.TrimSpace(str)
+	if key, err := strconv.ParseUint(str, 10, 64); err == nil {
+		if _, found := lookupEnumInfo(infos, key); found {
+			return key, nil
+		}
+	}
+	for _, info := range infos {
+		if strings.EqualFold(info.Name, str) || (info.Abbreviation != "" && strings.EqualFold(info.Abbreviation, str)) {
+			return info.Key, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown enumeration: %s", str)
+}
+
+// enumKeyFromName finds the key for a name within an enumeration map
+// A numeric string is accepted as the key itself, even where this build does not know
+// the value, so that tags written against a newer specification survive a round trip
+func enumKeyFromName(names map[uint64]string, str string) (uint64, error) {
+	// Hardly efficient, but this is not critical here
+	for key, name := range names {
+		if name == str {
+			return key, nil
+		}
+	}
+	if key, err := strconv.ParseUint(str, 10, 64); err == nil {
+		return key, nil
+	}
+	return 0, fmt.Errorf("unknown enumeration: %s", str)
+}
@@ -0,0 +1,239 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors for common failure cases, these can be tested for with errors.Is
+// against any error returned by Decode or Encode
+var (
+	// ErrTruncated indicates that the tag data ended unexpectedly
+	ErrTruncated = errors.New("tag data is truncated")
+
+	// ErrInvalidCapabilityContainer indicates the capability container is not valid
+	ErrInvalidCapabilityContainer = errors.New("invalid capability container")
+
+	// ErrNoNDEFTLV indicates that no NDEF TLV was found in the tag
+	ErrNoNDEFTLV = errors.New("did not find NDEF TLV")
+
+	// ErrInvalidNDEF indicates that the NDEF message could not be parsed or generated
+	ErrInvalidNDEF = errors.New("invalid NDEF message")
+
+	// ErrNoOpenPrintTagRecord indicates that the NDEF message has no open print tag record
+	ErrNoOpenPrintTagRecord = errors.New("did not find an open print tag record")
+
+	// ErrInvalidRegion indicates that a region does not contain valid CBOR data
+	ErrInvalidRegion = errors.New("invalid region")
+
+	// ErrInvalidRegionOffset indicates that a region offset lies outside of the payload
+	ErrInvalidRegionOffset = errors.New("invalid region offset")
+
+	// ErrRegionTooLarge indicates that a region exceeds the maximum permissable size
+	ErrRegionTooLarge = errors.New("region too large")
+
+	// ErrInsufficientSpace indicates that the data does not fit in the available space
+	ErrInsufficientSpace = errors.New("insufficient space")
+
+	// ErrInvalidTagSize indicates that the tag size cannot be represented
+	ErrInvalidTagSize = errors.New("invalid tag size")
+)
+
+// ErrorLayer identifies the layer of the tag structure in which an error occurred
+type ErrorLayer int
+
+const (
+	// LayerCC is the capability container
+	LayerCC ErrorLayer = iota
+	// LayerTLV is the TLV structure wrapping the NDEF message
+	LayerTLV
+	// LayerNDEF is the NDEF message and its records
+	LayerNDEF
+	// LayerMeta is the meta region
+	LayerMeta
+	// LayerMain is the main region
+	LayerMain
+	// LayerAux is the aux region
+	LayerAux
+)
+
+// String returns the name of the layer
+func (l ErrorLayer) String() string {
+	switch l {
+	case LayerCC:
+		return "CC"
+	case LayerTLV:
+		return "TLV"
+	case LayerNDEF:
+		return "NDEF"
+	case LayerMeta:
+		return "meta"
+	case LayerMain:
+		return "main"
+	case LayerAux:
+		return "aux"
+	default:
+		return fmt.Sprintf("layer(%d)", int(l))
+	}
+}
+
+// ErrorReason is a stable code identifying the reason for a decode or encode error
+// Each reason corresponds to one of the sentinel errors
+type ErrorReason int
+
+const (
+	ReasonTruncated ErrorReason = iota
+	ReasonInvalidCapabilityContainer
+	ReasonNoNDEFTLV
+	ReasonInvalidNDEF
+	ReasonNoOpenPrintTagRecord
+	ReasonInvalidRegion
+	ReasonInvalidRegionOffset
+	ReasonRegionTooLarge
+	ReasonInsufficientSpace
+	ReasonInvalidTagSize
+)
+
+// reasonErrors maps each reason to its sentinel error
+var reasonErrors = map[ErrorReason]error{
+	ReasonTruncated:                  ErrTruncated,
+	ReasonInvalidCapabilityContainer: ErrInvalidCapabilityContainer,
+	ReasonNoNDEFTLV:                  ErrNoNDEFTLV,
+	ReasonInvalidNDEF:                ErrInvalidNDEF,
+	ReasonNoOpenPrintTagRecord:       ErrNoOpenPrintTagRecord,
+	ReasonInvalidRegion:              ErrInvalidRegion,
+	ReasonInvalidRegionOffset:        ErrInvalidRegionOffset,
+	ReasonRegionTooLarge:             ErrRegionTooLarge,
+	ReasonInsufficientSpace:          ErrInsufficientSpace,
+	ReasonInvalidTagSize:             ErrInvalidTagSize,
+}
+
+// String returns the text of the sentinel error for the reason
+func (r ErrorReason) String() string {
+	if err, ok := reasonErrors[r]; ok {
+		return err.Error()
+	}
+	return fmt.Sprintf("reason(%d)", int(r))
+}
+
+// DecodeError is returned by Decode, describing where and why decoding failed
+type DecodeError struct {
+	// Reason is the reason for the failure
+	Reason ErrorReason
+
+	// Layer is the layer of the tag in which the failure occurred
+	Layer ErrorLayer
+
+	// Offset is the absolute byte offset within the tag data at which the failure occurred
+	Offset int
+
+	// Message describes the failure
+	Message string
+
+	// Err is the underlying cause, if any
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return formatTagError(e.Layer, e.Offset, e.Message, e.Err)
+}
+
+// Unwrap returns the underlying cause
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Is allows errors.Is to match the sentinel error for the reason
+func (e *DecodeError) Is(target error) bool {
+	return target != nil && reasonErrors[e.Reason] == target
+}
+
+// EncodeError is returned by Encode, describing where and why encoding failed
+type EncodeError struct {
+	// Reason is the reason for the failure
+	Reason ErrorReason
+
+	// Layer is the layer of the tag in which the failure occurred
+	Layer ErrorLayer
+
+	// Offset is the absolute byte offset within the encoded tag at which the failure
+	// occurred, or -1 where this is not applicable
+	Offset int
+
+	// Message describes the failure
+	Message string
+
+	// Err is the underlying cause, if any
+	Err error
+}
+
+func (e *EncodeError) Error() string {
+	return formatTagError(e.Layer, e.Offset, e.Message, e.Err)
+}
+
+// Unwrap returns the underlying cause
+func (e *EncodeError) Unwrap() error {
+	return e.Err
+}
+
+// Is allows errors.Is to match the sentinel error for the reason
+func (e *EncodeError) Is(target error) bool {
+	return target != nil && reasonErrors[e.Reason] == target
+}
+
+// formatTagError provides the common error text for decode and encode errors
+func formatTagError(layer ErrorLayer, offset int, message string, cause error) string {
+	str := message
+	if cause != nil {
+		str = fmt.Sprintf("%s: %v", str, cause)
+	}
+	if offset >= 0 {
+		str = fmt.Sprintf("%s (%s layer, offset %d)", str, layer, offset)
+	}
+	return str
+}
+
+// failDecode panics with a DecodeError, which Decode will return
+func failDecode(reason ErrorReason, layer ErrorLayer, offset int, cause error, format string, args ...any) {
+	panic(&DecodeError{Reason: reason, Layer: layer, Offset: offset, Message: fmt.Sprintf(format, args...), Err: cause})
+}
+
+// assertDecode fails decoding with a DecodeError if condition is false
+func assertDecode(condition bool, reason ErrorReason, layer ErrorLayer, offset int, format string, args ...any) {
+	if !condition {
+		failDecode(reason, layer, offset, nil, format, args...)
+	}
+}
+
+// failEncode panics with an EncodeError, which Encode will return
+func failEncode(reason ErrorReason, layer ErrorLayer, offset int, cause error, format string, args ...any) {
+	panic(&EncodeError{Reason: reason, Layer: layer, Offset: offset, Message: fmt.Sprintf(format, args...), Err: cause})
+}
+
+// assertEncode fails encoding with an EncodeError if condition is false
+func assertEncode(condition bool, reason ErrorReason, layer ErrorLayer, offset int, format string, args ...any) {
+	if !condition {
+		failEncode(reason, layer, offset, nil, format, args...)
+	}
+}
@@ -0,0 +1,128 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import "slices"
+
+// FieldType is the specification data type of a field
+type FieldType string
+
+const (
+	FieldTypeUint64    FieldType = "uint64"
+	FieldTypeInt       FieldType = "int"
+	FieldTypeNumber    FieldType = "number"
+	FieldTypeString    FieldType = "string"
+	FieldTypeUUID      FieldType = "uuid"
+	FieldTypeColorRGBA FieldType = "color_rgba"
+	FieldTypeTimestamp FieldType = "timestamp"
+	FieldTypeEnum      FieldType = "enum"
+	FieldTypeEnumArray FieldType = "enum_array"
+)
+
+// FieldRequirement indicates whether the specification requires a field to be present
+type FieldRequirement int
+
+const (
+	FieldOptional FieldRequirement = iota
+	FieldRecommended
+	FieldRequired
+)
+
+func (r FieldRequirement) String() string {
+	switch r {
+	case FieldRecommended:
+		return "recommended"
+	case FieldRequired:
+		return "required"
+	default:
+		return "optional"
+	}
+}
+
+// FieldInfo describes a field as defined by the specification
+type FieldInfo struct {
+	// Region is the region holding the field: meta, main or aux
+	Region string
+
+	// Key is the CBOR key of the field
+	Key int
+
+	// Name is the native (snake case) field name
+	Name string
+
+	// Type is the specification data type
+	Type FieldType
+
+	// Enum is the name of the enumeration type for enum and enum_array fields
+	Enum string
+
+	// Unit is the unit of measure, if any, for example "g" or "°C"
+	Unit string
+
+	// Description is as given by the specification, where present
+	Description string
+
+	// Requirement is whether the field is required, recommended or optional
+	Requirement FieldRequirement
+
+	// MaxLength is the maximum length of string and enum_array fields, 0 if unlimited
+	MaxLength int
+
+	// Deprecated fields can still be read and written, but should no longer be used
+	Deprecated bool
+
+	// ReplacedBy names the field replacing a deprecated field, where the specification gives one
+	ReplacedBy string
+}
+
+// MetaFields returns information on all meta region fields
+func MetaFields() []FieldInfo {
+	return slices.Clone(metaFieldInfo)
+}
+
+// MainFields returns information on all main region fields
+func MainFields() []FieldInfo {
+	return slices.Clone(mainFieldInfo)
+}
+
+// AuxFields returns information on all aux region fields
+func AuxFields() []FieldInfo {
+	return slices.Clone(auxFieldInfo)
+}
+
+// LookupField finds a field by region (meta, main or aux) and native field name
+func LookupField(region, name string) (FieldInfo, bool) {
+	var fields []FieldInfo
+	switch region {
+	case "meta":
+		fields = metaFieldInfo
+	case "main":
+		fields = mainFieldInfo
+	case "aux":
+		fields = auxFieldInfo
+	}
+	idx := slices.IndexFunc(fields, func(f FieldInfo) bool { return f.Name == name })
+	if idx < 0 {
+		return FieldInfo{}, false
+	}
+	return fields[idx], true
+}
@@ -0,0 +1,222 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// lengthWeightTolerance is the relative difference allowed between a full length
+// and the length expected from the weight, density and diameter before it is flagged
+const lengthWeightTolerance = 0.05
+
+// ComputedValues holds values derived from the tag data, each is nil where the
+// tag does not hold enough information to derive it
+type ComputedValues struct {
+	// RemainingWeight is the net weight of material remaining, in g
+	RemainingWeight *float64 `yaml:"remaining_weight,omitempty" json:"remaining_weight,omitempty"`
+
+	// RemainingLength is the length of filament remaining, in mm
+	RemainingLength *float64 `yaml:"remaining_length,omitempty" json:"remaining_length,omitempty"`
+
+	// RemainingPercent is the percentage of material remaining
+	RemainingPercent *float64 `yaml:"remaining_percent,omitempty" json:"remaining_percent,omitempty"`
+
+	// GrossWeight is the expected weight of the container and remaining material on a scale, in g
+	GrossWeight *float64 `yaml:"gross_weight,omitempty" json:"gross_weight,omitempty"`
+
+	// RemainingVolume is set for resins, the volume of resin remaining in ml (see RemainingVolume)
+	RemainingVolume *float64 `yaml:"remaining_volume,omitempty" json:"remaining_volume,omitempty"`
+
+	// StirringDue is set for resins, true where the resin needs stirring (see StirringDue)
+	StirringDue *bool `yaml:"stirring_due,omitempty" json:"stirring_due,omitempty"`
+
+	// Warnings lists inconsistencies found in the data used for the computation
+	Warnings []string `yaml:"warnings,omitempty" json:"warnings,omitempty"`
+}
+
+// ComputeValues returns all values that can be derived from the tag data
+func (o *OpenPrintTag) ComputeValues() *ComputedValues {
+	computed := &ComputedValues{}
+	optional := func(value float64, ok bool) *float64 {
+		if !ok {
+			return nil
+		}
+		// Rounded for presentation, the methods provide full precision
+		rounded := math.Round(value*100) / 100
+		return &rounded
+	}
+	computed.RemainingWeight = optional(o.RemainingWeight())
+	computed.RemainingLength = optional(o.RemainingLength())
+	computed.RemainingPercent = optional(o.RemainingPercent())
+	computed.GrossWeight = optional(o.GrossWeight())
+	if class, found := o.main.GetMaterialClass(); found && class == MaterialClassSLA {
+		computed.RemainingVolume = optional(o.RemainingVolume())
+		due := o.StirringDue()
+		computed.StirringDue = &due
+	}
+	computed.Warnings = o.lengthWeightMismatches()
+	return computed
+}
+
+// FullWeight returns the net weight of material in a full container in g,
+// being actual_netto_full_weight where set, otherwise nominal_netto_full_weight
+func (o *OpenPrintTag) FullWeight() (float64, bool) {
+	if weight, found := o.main.GetActualNettoFullWeight(); found {
+		return weight, true
+	}
+	return o.main.GetNominalNettoFullWeight()
+}
+
+// consumedWeight returns the consumed weight from the aux region, zero where not recorded
+func (o *OpenPrintTag) consumedWeight() (float64, bool) {
+	if o.aux == nil {
+		return 0, false
+	}
+	return o.aux.GetConsumedWeight()
+}
+
+// RemainingWeight returns the net weight of material remaining in g, being the full
+// weight (see FullWeight) less the consumed_weight recorded in the aux region
+func (o *OpenPrintTag) RemainingWeight() (float64, bool) {
+	full, found := o.FullWeight()
+	if !found {
+		return 0, false
+	}
+	consumed, _ := o.consumedWeight()
+	return math.Max(full-consumed, 0), true
+}
+
+// RemainingPercent returns the percentage of the full weight that remains
+func (o *OpenPrintTag) RemainingPercent() (float64, bool) {
+	full, found := o.FullWeight()
+	if !found || full <= 0 {
+		return 0, false
+	}
+	remaining, _ := o.RemainingWeight()
+	return remaining / full * 100, true
+}
+
+// RemainingLength returns the length of filament remaining in mm. This is calculated from
+// the remaining weight, density and filament_diameter where they are set, otherwise from
+// actual_full_length (or nominal_full_length) in proportion to the weight remaining
+func (o *OpenPrintTag) RemainingLength() (float64, bool) {
+	if remaining, found := o.RemainingWeight(); found {
+		if length, found := o.lengthFromWeight(remaining); found {
+			return length, true
+		}
+	}
+
+	full, found := o.main.GetActualFullLength()
+	if !found {
+		full, found = o.main.GetNominalFullLength()
+	}
+	if !found {
+		return 0, false
+	}
+	if percent, found := o.RemainingPercent(); found {
+		return full * percent / 100, true
+	}
+	if _, consumed := o.consumedWeight(); consumed {
+		// Some has been used, but without a full weight we cannot tell how much
+		return 0, false
+	}
+	return full, true
+}
+
+// lengthFromWeight converts a weight of filament in g to a length in mm using
+// the density and filament_diameter
+func (o *OpenPrintTag) lengthFromWeight(weight float64) (float64, bool) {
+	density, densityFound := o.main.GetDensity()
+	diameter, diameterFound := o.main.GetFilamentDiameter()
+	if !densityFound || !diameterFound || density <= 0 || diameter <= 0 {
+		return 0, false
+	}
+	// g/cm³ to g/mm³
+	volume := weight / (density / 1000)
+	area := math.Pi * diameter * diameter / 4
+	return volume / area, true
+}
+
+// GrossWeight returns the weight in g that a scale should show for the container
+// with the remaining material, being the remaining weight plus empty_container_weight
+func (o *OpenPrintTag) GrossWeight() (float64, bool) {
+	remaining, found := o.RemainingWeight()
+	if !found {
+		return 0, false
+	}
+	container, found := o.main.GetEmptyContainerWeight()
+	if !found {
+		return 0, false
+	}
+	return remaining + container, true
+}
+
+// ConsumedWeightFromGross returns the consumed weight in g given the gross weight of the
+// container and remaining material measured on a scale, suitable for the aux
+// consumed_weight field. The result is limited to the range 0 to the full weight, as
+// scales and container weights are not exact
+func (o *OpenPrintTag) ConsumedWeightFromGross(gross float64) (float64, error) {
+	full, found := o.FullWeight()
+	if !found {
+		return 0, errors.New("actual_netto_full_weight or nominal_netto_full_weight is required to calculate consumed weight")
+	}
+	container, found := o.main.GetEmptyContainerWeight()
+	if !found {
+		return 0, errors.New("empty_container_weight is required to calculate consumed weight")
+	}
+	if gross < 0 {
+		return 0, fmt.Errorf("gross weight of %g g is negative", gross)
+	}
+	consumed := full - (gross - container)
+	return math.Min(math.Max(consumed, 0), full), nil
+}
+
+// lengthWeightMismatches flags full lengths that disagree with the length expected from the
+// matching full weight, density and filament diameter
+func (o *OpenPrintTag) lengthWeightMismatches() (warnings []string) {
+	pairs := []struct {
+		lengthName, weightName string
+		length, weight         func() (float64, bool)
+	}{
+		{"nominal_full_length", "nominal_netto_full_weight", o.main.GetNominalFullLength, o.main.GetNominalNettoFullWeight},
+		{"actual_full_length", "actual_netto_full_weight", o.main.GetActualFullLength, o.main.GetActualNettoFullWeight},
+	}
+	for _, pair := range pairs {
+		length, lengthFound := pair.length()
+		weight, weightFound := pair.weight()
+		if !lengthFound || !weightFound {
+			continue
+		}
+		expected, found := o.lengthFromWeight(weight)
+		if !found || expected <= 0 {
+			continue
+		}
+		if math.Abs(length-expected)/expected > lengthWeightTolerance {
+			warnings = append(warnings, fmt.Sprintf("%s of %.0f mm disagrees with %.0f mm expected from %s, density and filament_diameter",
+				pair.lengthName, length, expected, pair.weightName))
+		}
+	}
+	return
+}
@@ -0,0 +1,375 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/google/uuid"
+	"github.com/x448/float16"
+
+	st "github.com/cjbearman/openprinttag/structtags"
+)
+
+// FitOptions controls the degradation steps taken by EncodeToFit
+type FitOptions struct {
+	// FloatTolerance is the maximum relative error (e.g. 0.001 for 0.1%) permitted when
+	// reducing the precision of a floating point field. Zero disables precision reduction
+	FloatTolerance float64
+
+	// DropDerivableUUIDs permits brand, material and package UUIDs to be dropped where they
+	// are identical to the UUID that would be derived from the brand name, material name and GTIN
+	DropDerivableUUIDs bool
+
+	// DropPriority lists optional fields that may be dropped, in the order they should be dropped
+	// Fields are given by native name, prefixed with the region ("aux.consumed_weight")
+	// where not in the main region
+	DropPriority []string
+}
+
+// FitAction describes the kind of change made by EncodeToFit
+type FitAction string
+
+const (
+	// FitActionReducedPrecision indicates a floating point value was stored with reduced precision
+	FitActionReducedPrecision FitAction = "reduced_precision"
+
+	// FitActionDroppedUUID indicates a UUID was dropped, since readers can derive it
+	FitActionDroppedUUID FitAction = "dropped_derivable_uuid"
+
+	// FitActionDroppedField indicates an optional field was dropped
+	FitActionDroppedField FitAction = "dropped_field"
+)
+
+// FitChange is a single change made to a tag so that it would fit
+type FitChange struct {
+	Region string    `yaml:"region" json:"region"`
+	Field  string    `yaml:"field" json:"field"`
+	Action FitAction `yaml:"action" json:"action"`
+	From   any       `yaml:"from,omitempty" json:"from,omitempty"`
+	To     any       `yaml:"to,omitempty" json:"to,omitempty"`
+}
+
+// FitReport lists the changes made by EncodeToFit, in the order they were made
+type FitReport struct {
+	Changes []FitChange `yaml:"changes" json:"changes"`
+}
+
+// fitField is a field of a region that may be degraded
+type fitField struct {
+	region  string
+	name    string
+	key     uint64
+	options map[string]string
+	value   reflect.Value
+	opts    *RegionOptions
+}
+
+// EncodeToFit encodes the tag like Encode but, where the data does not fit, degrades the
+// data step by step until it does:
+// first placing the aux region afresh, where a decoded tag holds an aux region offset,
+// then reducing the precision of floating point fields within the tolerance,
+// then dropping derivable UUIDs and
+// finally dropping optional fields in the given priority order.
+// Each step stops as soon as the data fits, and the tag is left holding the degraded data.
+// The report lists exactly what was changed. If the data cannot be made to fit, the tag
+// is left unchanged and the last encode error is returned
+func (o *OpenPrintTag) EncodeToFit(fit FitOptions, opts ...EncodeDecodeOption) (result []byte, report *FitReport, err error) {
+	if RecoverAssertions {
+		defer func() {
+			if r := recover(); r != nil {
+				switch x := r.(type) {
+				case string:
+					err = errors.New(x)
+				case error:
+					err = x
+				default:
+					err = errors.New("unknown panic occurred")
+				}
+			}
+		}()
+	}
+	return o.encodeToFit(fit, opts...)
+}
+
+// encodeToFit implements EncodeToFit, with assertion panics
+func (o *OpenPrintTag) encodeToFit(fit FitOptions, opts ...EncodeDecodeOption) ([]byte, *FitReport, error) {
+	report := &FitReport{}
+
+	restore := o.snapshotRegions()
+
+	// Validate the drop list before changing anything
+	var dropFields []fitField
+	for _, name := range fit.DropPriority {
+		field, found := o.findFitField(name)
+		if !found {
+			return nil, nil, fmt.Errorf("unknown field %s in drop priority list", name)
+		}
+		if _, required := field.options[st.OptTagRequired]; required {
+			return nil, nil, fmt.Errorf("field %s is required and cannot be dropped", name)
+		}
+		dropFields = append(dropFields, field)
+	}
+
+	// tryEncode returns the data if it now fits, failing on any error other than lack of space
+	var lastErr error
+	tryEncode := func() ([]byte, bool, error) {
+		data, err := o.encodeRecovered(opts...)
+		if err == nil {
+			return data, true, nil
+		}
+		lastErr = err
+		if errors.Is(err, ErrInsufficientSpace) || errors.Is(err, ErrRegionTooLarge) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	if data, fits, err := tryEncode(); fits || err != nil {
+		return data, report, err
+	}
+
+	// An aux region offset carried over from a decoded tag may not suit the data or
+	// size, so let the encoder place the aux region afresh for the trial encodes
+	o.meta.ClearAuxRegionOffset()
+	if data, fits, err := tryEncode(); fits || err != nil {
+		if err != nil {
+			restore()
+		}
+		return data, report, err
+	}
+
+	// Each degradation is applied one field at a time, stopping as soon as the data fits
+	var steps []func() bool
+	if fit.FloatTolerance > 0 {
+		for _, field := range o.fitFields() {
+			field := field
+			if field.value.Type().Elem().Kind() == reflect.Float64 {
+				steps = append(steps, func() bool { return field.reducePrecision(fit.FloatTolerance, report) })
+			}
+		}
+	}
+	if fit.DropDerivableUUIDs {
+		steps = append(steps, o.uuidDropSteps(report)...)
+	}
+	for _, field := range dropFields {
+		field := field
+		steps = append(steps, func() bool {
+			if field.value.IsNil() {
+				return false
+			}
+			report.Changes = append(report.Changes, FitChange{Region: field.region, Field: field.name, Action: FitActionDroppedField, From: field.value.Elem().Interface()})
+			field.value.Set(reflect.Zero(field.value.Type()))
+			return true
+		})
+	}
+
+	for _, step := range steps {
+		if !step() {
+			continue
+		}
+		if data, fits, err := tryEncode(); fits {
+			return data, report, nil
+		} else if err != nil {
+			restore()
+			return nil, nil, err
+		}
+	}
+
+	restore()
+	return nil, nil, lastErr
+}
+
+// snapshotRegions records the data and encoding options of all regions, returning a function
+// that restores them. Field values are replaced (not modified in place) so a shallow copy of
+// each region's data suffices
+func (o *OpenPrintTag) snapshotRegions() (restore func()) {
+	metaInternalCopy, mainInternalCopy := o.meta.internal, o.main.internal
+	metaOptionsCopy, mainOptionsCopy := o.meta.regionOptions.clone(), o.main.regionOptions.clone()
+	var auxInternalCopy auxInternal
+	var auxOptionsCopy *RegionOptions
+	if o.aux != nil {
+		auxInternalCopy, auxOptionsCopy = o.aux.internal, o.aux.regionOptions.clone()
+	}
+	return func() {
+		o.meta.internal, *o.meta.regionOptions = metaInternalCopy, *metaOptionsCopy
+		o.main.internal, *o.main.regionOptions = mainInternalCopy, *mainOptionsCopy
+		if o.aux != nil && auxOptionsCopy != nil {
+			o.aux.internal, *o.aux.regionOptions = auxInternalCopy, *auxOptionsCopy
+		}
+	}
+}
+
+// encodeRecovered runs encode, converting typed error panics into errors, so that
+// failures to fit can be retried
+func (o *OpenPrintTag) encodeRecovered(opts ...EncodeDecodeOption) (data []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			switch x := r.(type) {
+			case *EncodeError:
+				err = x
+			default:
+				panic(r)
+			}
+		}
+	}()
+	return o.encode(opts...)
+}
+
+// fitFields returns the fields of the main and aux regions, in key order
+func (o *OpenPrintTag) fitFields() []fitField {
+	fields := regionFitFields("main", reflect.ValueOf(&o.main.internal).Elem(), o.main.regionOptions)
+	if o.aux != nil {
+		fields = append(fields, regionFitFields("aux", reflect.ValueOf(&o.aux.internal).Elem(), o.aux.regionOptions)...)
+	}
+	return fields
+}
+
+// regionFitFields lists the fields of one region internal struct
+func regionFitFields(region string, internal reflect.Value, opts *RegionOptions) []fitField {
+	fields := []fitField{}
+	for i := 0; i < internal.NumField(); i++ {
+		tag := internal.Type().Field(i).Tag.Get(st.OptTag)
+		if tag == "" {
+			continue
+		}
+		options := decodeOptTag(tag)
+		key, _ := strconv.ParseUint(options[st.OptTagKey], 10, 64)
+		fields = append(fields, fitField{
+			region:  region,
+			name:    options[st.OptTagName],
+			key:     key,
+			options: options,
+			value:   internal.Field(i),
+			opts:    opts,
+		})
+	}
+	return fields
+}
+
+// findFitField finds a field by (optionally region qualified) native name
+func (o *OpenPrintTag) findFitField(name string) (fitField, bool) {
+	region, fieldName := "main", name
+	if before, after, found := strings.Cut(name, "."); found {
+		region, fieldName = before, after
+	}
+	for _, field := range o.fitFields() {
+		if field.region == region && field.name == fieldName {
+			return field, true
+		}
+	}
+	return fitField{}, false
+}
+
+// reducePrecision replaces a floating point value with the most compact form within tolerance
+// returning false if no smaller form is available
+func (f fitField) reducePrecision(tolerance float64, report *FitReport) bool {
+	if f.value.IsNil() {
+		return false
+	}
+	original := f.value.Elem().Float()
+
+	candidates := []float64{
+		math.Round(original),
+		float64(float16.Fromfloat32(float32(original)).Float32()),
+		float64(float32(original)),
+	}
+	best, bestSize := original, encodedFloatSize(original, f.opts)
+	for _, candidate := range candidates {
+		if math.Abs(candidate-original) > tolerance*math.Abs(original) {
+			continue
+		}
+		if size := encodedFloatSize(candidate, f.opts); size < bestSize {
+			best, bestSize = candidate, size
+		}
+	}
+	if best == original {
+		return false
+	}
+
+	report.Changes = append(report.Changes, FitChange{Region: f.region, Field: f.name, Action: FitActionReducedPrecision, From: original, To: best})
+	f.value.Set(reflect.ValueOf(&best))
+
+	// The recorded width of a decoded value no longer applies
+	delete(f.opts.floatEncodings, f.key)
+	return true
+}
+
+// encodedFloatSize returns the number of bytes a floating point value occupies when encoded
+func encodedFloatSize(value float64, opts *RegionOptions) int {
+	encmode, _ := cbor.EncOptions{ShortestFloat: cbor.ShortestFloat16}.EncMode()
+	data, err := encmode.Marshal(compressFloat(value, opts))
+	if err != nil {
+		return math.MaxInt
+	}
+	return len(data)
+}
+
+// uuidDropSteps returns a step for each UUID that readers can derive from other fields
+func (o *OpenPrintTag) uuidDropSteps(report *FitReport) []func() bool {
+	main := o.main
+
+	// brandUUID is the basis of the other derivations, either as set or as derived
+	brandUUID := func() (uuid.UUID, bool) {
+		if brand, found := main.GetBrandUuid(); found {
+			return brand, true
+		}
+		brandName, found := main.GetBrandName()
+		return BrandUUID(brandName), found
+	}
+
+	drop := func(name string, current func() (uuid.UUID, bool), derive func() (uuid.UUID, bool), clear func() *MainRegion) func() bool {
+		return func() bool {
+			value, found := current()
+			derived, derivable := derive()
+			if !found || !derivable || value != derived {
+				return false
+			}
+			report.Changes = append(report.Changes, FitChange{Region: "main", Field: name, Action: FitActionDroppedUUID, From: value.String()})
+			clear()
+			return true
+		}
+	}
+
+	return []func() bool{
+		drop("brand_uuid", main.GetBrandUuid, func() (uuid.UUID, bool) {
+			brandName, found := main.GetBrandName()
+			return BrandUUID(brandName), found
+		}, main.ClearBrandUuid),
+		drop("material_uuid", main.GetMaterialUuid, func() (uuid.UUID, bool) {
+			materialName, found := main.GetMaterialName()
+			brand, brandFound := brandUUID()
+			return MaterialUUID(materialName, brand), found && brandFound
+		}, main.ClearMaterialUuid),
+		drop("package_uuid", main.GetPackageUuid, func() (uuid.UUID, bool) {
+			gtin, found := main.GetGtin()
+			brand, brandFound := brandUUID()
+			return MaterialPackageUUID(fmt.Sprintf("%d", gtin), brand), found && brandFound && CheckGTIN(gtin) == nil
+		}, main.ClearPackageUuid),
+	}
+}
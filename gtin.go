@@ -0,0 +1,98 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// gtinLengths are the valid lengths of GTIN-8, GTIN-12 (UPC-A), GTIN-13 (EAN-13) and GTIN-14
+var gtinLengths = []int{8, 12, 13, 14}
+
+// GTINCheckDigit returns the check digit for the digits of a GTIN, excluding the check digit
+func GTINCheckDigit(payload string) (int, error) {
+	sum := 0
+	for idx := 0; idx < len(payload); idx++ {
+		digit := payload[len(payload)-1-idx]
+		if digit < '0' || digit > '9' {
+			return 0, fmt.Errorf("GTIN %q contains a non digit", payload)
+		}
+		// Weights alternate 3, 1, 3... from the digit nearest the check digit
+		weight := 1
+		if idx%2 == 0 {
+			weight = 3
+		}
+		sum += int(digit-'0') * weight
+	}
+	return (10 - sum%10) % 10, nil
+}
+
+// ParseGTIN parses a GTIN-8, GTIN-12, GTIN-13 or GTIN-14, ignoring spaces and hyphens,
+// validating the check digit and returning the value as stored in the gtin field
+func ParseGTIN(str string) (uint64, error) {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(str))
+	if !slices.Contains(gtinLengths, len(digits)) {
+		return 0, fmt.Errorf("GTIN %q must have 8, 12, 13 or 14 digits", str)
+	}
+	if err := checkGTINDigits(digits); err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(digits, 10, 64)
+}
+
+// CheckGTIN validates the check digit of a GTIN as stored in the gtin field, where
+// leading zeros are not retained
+func CheckGTIN(gtin uint64) error {
+	digits := strconv.FormatUint(gtin, 10)
+	if len(digits) > 14 {
+		return fmt.Errorf("GTIN %s has more than 14 digits", digits)
+	}
+	if gtin == 0 {
+		return errors.New("GTIN must not be zero")
+	}
+	return checkGTINDigits(digits)
+}
+
+// FormatGTIN returns a GTIN as stored in the gtin field in its printed form, zero padded
+// to 13 digits (EAN-13, which also represents GTIN-8 and GTIN-12) or 14 digits for GTIN-14
+func FormatGTIN(gtin uint64) string {
+	return fmt.Sprintf("%013d", gtin)
+}
+
+// checkGTINDigits validates the check digit, which is the last digit
+func checkGTINDigits(digits string) error {
+	expected, err := GTINCheckDigit(digits[:len(digits)-1])
+	if err != nil {
+		return err
+	}
+	if last := digits[len(digits)-1]; last < '0' || last > '9' {
+		return fmt.Errorf("GTIN %q contains a non digit", digits)
+	}
+	if actual := int(digits[len(digits)-1] - '0'); actual != expected {
+		return fmt.Errorf("GTIN %s has check digit %d, expected %d", digits, actual, expected)
+	}
+	return nil
+}
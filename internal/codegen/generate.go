@@ -71,5 +71,6 @@ func main() {
 
 	generators.GenerateEnums(pkgDir)
 	generators.GenerateStructs(pkgDir)
+	generators.GenerateSchema(pkgDir)
 
 }
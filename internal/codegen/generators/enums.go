@@ -26,6 +26,7 @@ import (
 	"bytes"
 	"fmt"
 	"path/filepath"
+	"unicode"
 
 	"github.com/cjbearman/openprinttag/internal/config"
 )
@@ -84,7 +85,7 @@ func generateEnum(filename string, field config.Field, enumerations []config.Enu
 	// Preamble contains package and auto-generated file comment
 	wr.WriteString(enumsPreamble)
 
-	wr.WriteString("import (\n	\"fmt\"\n  \"gopkg.in/yaml.v3\"\n)\n\n")
+	wr.WriteString("import (\n	\"encoding/json\"\n	\"fmt\"\n  \"gopkg.in/yaml.v3\"\n)\n\n")
 
 	// Generate the enumeration type with integer base
 	fmt.Fprintf(wr, "type %s uint64\n\n", field.GetInternalEnumType())
@@ -93,12 +94,6 @@ func generateEnum(filename string, field config.Field, enumerations []config.Enu
 	fmt.Fprintf(wr, "const (\n")
 	for _, enum := range enumerations {
 
-		// Skip deprecated enumerations
-		if enum.IsDeprecated() {
-
-			continue
-		}
-
 		// Get the internal enumeration name, also an indication as to whether it has been abbreviated
 		en, abbreviated := enum.GetInternalEnumName(field)
 
@@ -112,6 +107,10 @@ func generateEnum(filename string, field config.Field, enumerations []config.Enu
 		if enum.Description() != "" {
 			fmt.Fprintf(wr, "  // %s\n", enum.Description())
 		}
+		// Deprecated values are still generated, so that existing tags can be read
+		if enum.IsDeprecated() {
+			fmt.Fprintf(wr, "  //\n  // %s\n", deprecationComment("value", enum.ReplacedBy()))
+		}
 
 		// Write the actual enumerated name and value
 		fmt.Fprintf(wr, "  %s %s = %d\n\n", en, field.GetInternalEnumType(), enum.Key())
@@ -123,9 +122,6 @@ func generateEnum(filename string, field config.Field, enumerations []config.Enu
 	// Add a map of all enum values
 	fmt.Fprintf(wr, "var %s = map[uint64]string {\n", field.GetInternalEnumMapName())
 	for _, enum := range enumerations {
-		if enum.IsDeprecated() {
-			continue
-		}
 		value := enum.Abbreviation()
 		if value == "" {
 			value = enum.Name()
@@ -135,18 +131,52 @@ func generateEnum(filename string, field config.Field, enumerations []config.Enu
 	}
 	fmt.Fprintf(wr, "}\n\n")
 
+	// Add a table of information on all enum values
+	infoName := enumInfoVarName(field)
+	fmt.Fprintf(wr, "// %s holds the specification information for all %s values\n", infoName, field.GetInternalEnumType())
+	fmt.Fprintf(wr, "var %s = []EnumInfo {\n", infoName)
+	for _, enum := range enumerations {
+		fmt.Fprintf(wr, "  {\n")
+		fmt.Fprintf(wr, "    Key: %d,\n", enum.Key())
+		fmt.Fprintf(wr, "    Name: %q,\n", enum.Name())
+		optional := map[string]string{
+			"Abbreviation": enum.Abbreviation(),
+			"Description":  enum.Description(),
+		}
+		for _, key := range []string{"Abbreviation", "Description"} {
+			if optional[key] != "" {
+				fmt.Fprintf(wr, "    %s: %q,\n", key, optional[key])
+			}
+		}
+		if enum.IsDeprecated() {
+			fmt.Fprintf(wr, "    Deprecated: true,\n")
+		}
+		if enum.ReplacedBy() != "" {
+			fmt.Fprintf(wr, "    ReplacedBy: %q,\n", enum.ReplacedBy())
+		}
+		fmt.Fprintf(wr, "  },\n")
+	}
+	fmt.Fprintf(wr, "}\n\n")
+
 	// Generate a stringer to allow for reasonable debugging
 	fmt.Fprintf(wr, "func (e %s) String() string {\n", field.GetInternalEnumType())
 	fmt.Fprintf(wr, "  return %s[uint64(e)]\n", field.GetInternalEnumMapName())
 	fmt.Fprintf(wr, "}\n\n")
 
-	// Generate YAML marshal/unmarshal functions
+	// Generate YAML and JSON marshal/unmarshal functions
 	NewTemplater().
 		WithEnumName(field.GetInternalEnumType()).
 		WithMapName(field.GetInternalEnumMapName()).
 		WithFilename("enum_marshallers.template").
 		Generate(wr)
 
+	// Generate metadata accessors, parsing and listing functions
+	NewTemplater().
+		WithEnumName(field.GetInternalEnumType()).
+		WithVarName(infoName).
+		WithFilename("enum_metadata.template").
+		Generate(wr)
+
 	// Complete, flush the writer
 	wr.Flush()
 
@@ -157,6 +187,12 @@ func generateEnum(filename string, field config.Field, enumerations []config.Enu
 	return writeCodeFile(filename, formatted)
 }
 
+// enumInfoVarName returns the name of the information table for an enum
+func enumInfoVarName(f config.Field) string {
+	base := []rune(f.GetInternalEnumType())
+	return string(append([]rune{unicode.ToLower(base[0])}, base[1:]...)) + "Info"
+}
+
 // enumFileName returns the filename that we will use for a given enum
 func enumFileName(f config.Field) string {
 	return fmt.Sprintf("%s_enum.go", f.GetGeneratedEnumFilename())
@@ -0,0 +1,200 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package generators
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/cjbearman/openprinttag/internal/config"
+)
+
+const (
+	// SchemaFileName is the name of the generated JSON Schema for tag data files
+	SchemaFileName = "data_schema.json"
+
+	schemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+	// quantityPattern matches a number with an optional unit, as accepted for fields
+	// with a unit by FromYAML/FromJSON (see ParseQuantity)
+	quantityPattern = `^\s*[-+]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][-+]?[0-9]+)?\s*(\S.*)?$`
+)
+
+// schemaNode is a (sub)schema within the generated JSON Schema
+// Properties prefixed x- are annotations, ignored by validators but available to tooling
+type schemaNode struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	Minimum              *int                   `json:"minimum,omitempty"`
+	MaxLength            int                    `json:"maxLength,omitempty"`
+	MaxItems             int                    `json:"maxItems,omitempty"`
+	UniqueItems          bool                   `json:"uniqueItems,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Items                *schemaNode            `json:"items,omitempty"`
+	AnyOf                []*schemaNode          `json:"anyOf,omitempty"`
+	Properties           map[string]*schemaNode `json:"properties,omitempty"`
+	AdditionalProperties *bool                  `json:"additionalProperties,omitempty"`
+	Defs                 map[string]*schemaNode `json:"$defs,omitempty"`
+	Deprecated           bool                   `json:"deprecated,omitempty"`
+	Key                  *int                   `json:"x-key,omitempty"`
+	Unit                 string                 `json:"x-unit,omitempty"`
+	Required             bool                   `json:"x-required,omitempty"`
+	Recommended          bool                   `json:"x-recommended,omitempty"`
+}
+
+// GenerateSchema generates a JSON Schema describing the data section of
+// the YAML/JSON tag data files, for validating data files ahead of import
+func GenerateSchema(pkgDir string) {
+	cfg, err := config.GetConfig(config.ConfigNFCV)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to load configuration: %v", err))
+	}
+
+	closed := false
+	defs := map[string]*schemaNode{}
+	root := &schemaNode{
+		Schema:      schemaDialect,
+		Title:       "OpenPrintTag data file",
+		Description: "Tag data as read by FromYAML/FromJSON and optag -data",
+		Type:        "object",
+		Properties: map[string]*schemaNode{
+			"data": {
+				Type: "object",
+				Properties: map[string]*schemaNode{
+					"meta": regionSchema("meta region", cfg.MetaFields(), defs),
+					"main": regionSchema("main region", cfg.MainFields(), defs),
+					"aux":  regionSchema("aux region", cfg.AuxFields(), defs),
+				},
+				AdditionalProperties: &closed,
+			},
+			"uri": {Type: "string", Description: "URI record written ahead of the OpenPrintTag record"},
+		},
+		Defs: defs,
+	}
+
+	output, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		panic(fmt.Sprintf("Failed to marshal schema: %v", err))
+	}
+	if err := writeCodeFile(filepath.Join(pkgDir, SchemaFileName), append(output, '\n')); err != nil {
+		panic(err)
+	}
+}
+
+// regionSchema returns the schema for a single region, adding any enumerations
+// used by the region to defs
+func regionSchema(description string, fields []config.Field, defs map[string]*schemaNode) *schemaNode {
+	closed := false
+	region := &schemaNode{
+		Description: description,
+		Type:        "object",
+		Properties: map[string]*schemaNode{
+			// Unknown (and vendor specific) fields are keyed by number
+			"other": {Type: "object", Description: "Fields not defined by the specification, keyed by CBOR key"},
+		},
+		AdditionalProperties: &closed,
+	}
+
+	for _, field := range fields {
+		key := field.Key()
+		node := fieldSchema(field, defs)
+		if field.Unit() != "" {
+			node = quantitySchema(node)
+		}
+		node.Description = field.Description()
+		node.Key = &key
+		node.Unit = field.Unit()
+		node.Required = field.Required() == "true"
+		node.Recommended = field.Required() == "recommended"
+		node.Deprecated = field.IsDeprecated()
+		region.Properties[field.Name()] = node
+	}
+	return region
+}
+
+// fieldSchema returns the type specific schema for a field
+func fieldSchema(field config.Field, defs map[string]*schemaNode) *schemaNode {
+	zero := 0
+	switch field.Type() {
+	case "uint64", "timestamp":
+		return &schemaNode{Type: "integer", Minimum: &zero}
+	case "int":
+		return &schemaNode{Type: "integer"}
+	case "number":
+		return &schemaNode{Type: "number"}
+	case "string":
+		return &schemaNode{Type: "string", MaxLength: field.MaxLength()}
+	case "uuid":
+		return &schemaNode{Type: "string", Format: "uuid"}
+	case "color_rgba":
+		return &schemaNode{Type: "string", Pattern: "^#?([0-9a-fA-F]{6}|[0-9a-fA-F]{8})$"}
+	case "enum":
+		return &schemaNode{Ref: enumSchemaRef(field, defs)}
+	case "enum_array":
+		return &schemaNode{
+			Type:        "array",
+			Items:       &schemaNode{Ref: enumSchemaRef(field, defs)},
+			MaxItems:    field.MaxLength(),
+			UniqueItems: true,
+		}
+	default:
+		panic("Unhandled type " + field.Type())
+	}
+}
+
+// enumSchemaRef adds the enumeration used by a field to defs (once)
+// and returns a reference to it
+func enumSchemaRef(field config.Field, defs map[string]*schemaNode) string {
+	name := field.GetGeneratedEnumFilename()
+	if _, found := defs[name]; !found {
+		// Values are named as in the generated enumeration maps
+		values := []string{}
+		for _, enum := range field.EnumeratedValues() {
+			value := enum.Abbreviation()
+			if value == "" {
+				value = enum.Name()
+			}
+			values = append(values, value)
+		}
+		defs[name] = enumSchema(values)
+	}
+	return "#/$defs/" + name
+}
+
+// quantitySchema allows a field with a unit to be given either as a number in the unit
+// of the field, or as a quantity string such as "1 kg"
+func quantitySchema(node *schemaNode) *schemaNode {
+	return &schemaNode{AnyOf: []*schemaNode{node, {Type: "string", Pattern: quantityPattern}}}
+}
+
+// enumSchema allows an enumerated value by name, or by number, so that values unknown
+// to this version of the specification round trip
+func enumSchema(values []string) *schemaNode {
+	zero := 0
+	return &schemaNode{AnyOf: []*schemaNode{{Type: "string", Enum: values}, {Type: "integer", Minimum: &zero}}}
+}
@@ -25,6 +25,7 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"io"
 	"path/filepath"
 	"strings"
 
@@ -72,6 +73,12 @@ func generateStruct(filename string, prefix, name string, fields []config.Field,
 	var funcBuffer = new(bytes.Buffer)
 	var funcWriter = bufio.NewWriter(funcBuffer)
 
+	// This buffer will be used for the field registry generation, + writer
+	var infoBuffer = new(bytes.Buffer)
+	var infoWriter = bufio.NewWriter(infoBuffer)
+	fmt.Fprintf(infoWriter, "// %sFieldInfo lists all %s region fields defined by the specification\n", prefixLC, name)
+	fmt.Fprintf(infoWriter, "var %sFieldInfo = []FieldInfo{\n", prefixLC)
+
 	// This set is all imports needed for the file
 	// We may add to this later depending on the requirements for the individual types
 	// within the file
@@ -86,9 +93,8 @@ func generateStruct(filename string, prefix, name string, fields []config.Field,
 
 	// Add each field to the struct
 	for _, field := range fields {
-		if field.IsDeprecated() {
-			continue
-		}
+		// The registry lists every field
+		writeFieldInfo(infoWriter, name, field)
 
 		// Figure out what opt struct tag we'll put on this field
 		optAnnotations := []string{
@@ -107,6 +113,9 @@ func generateStruct(filename string, prefix, name string, fields []config.Field,
 		if field.Type() == "color_rgba" {
 			optAnnotations = append(optAnnotations, st.OptTagRGBA)
 		}
+		if field.IsDeprecated() {
+			optAnnotations = append(optAnnotations, st.OptTagDeprecated)
+		}
 
 		// Compile the finalized opt: struct tag
 		optAnnotation := fmt.Sprintf("%s:\"%s\"", st.OptTag, strings.Join(optAnnotations, ","))
@@ -121,7 +130,7 @@ func generateStruct(filename string, prefix, name string, fields []config.Field,
 		if field.Type() == "color_rgba" {
 			additionalYamlTags = ",flow"
 		}
-		fmt.Fprintf(structWriter, "  %s *%s `cbor:\"%d,keyasint,omitempty\" yaml:\"%s%s,omitempty\" %s`\n", field.GetInternalFieldName(), theType, field.Key(), field.Name(), additionalYamlTags, optAnnotation)
+		fmt.Fprintf(structWriter, "  %s *%s `cbor:\"%d,keyasint,omitempty\" yaml:\"%s%s,omitempty\" json:\"%s,omitempty\" %s`\n", field.GetInternalFieldName(), theType, field.Key(), field.Name(), additionalYamlTags, field.Name(), optAnnotation)
 
 		// Now, into the functions writer, we will generate appropriate setter/getter/clearer functions from our templates
 		// the template we use depends on the native type of the field
@@ -132,6 +141,12 @@ func generateStruct(filename string, prefix, name string, fields []config.Field,
 		if field.Description() != "" {
 			commentTemplate += "\n// " + field.Description()
 		}
+		if field.Unit() != "" {
+			commentTemplate += "\n// Unit: " + field.Unit()
+		}
+		if field.IsDeprecated() {
+			commentTemplate += "\n//\n// " + deprecationComment("field", field.ReplacedBy())
+		}
 
 		// Refine the base comment to be appropriate for getter, setter, clearer cases
 		setterComment := strings.ReplaceAll(strings.ReplaceAll(commentTemplate, "#type#", "Set"), "#op#", "Sets")
@@ -174,7 +189,8 @@ func generateStruct(filename string, prefix, name string, fields []config.Field,
 	}
 
 	// All internal structs have a map of unknown fields
-	fmt.Fprintf(structWriter, "  Unknowns map[any]any `cbor:\"-\" yaml:\"other,omitempty\"`")
+	// These are serialized to JSON by the generated JSON marshallers
+	fmt.Fprintf(structWriter, "  Unknowns map[any]any `cbor:\"-\" yaml:\"other,omitempty\" json:\"-\"`")
 
 	// The external struct needs a getter for unknown fields
 	NewTemplater().
@@ -182,6 +198,12 @@ func generateStruct(filename string, prefix, name string, fields []config.Field,
 		WithStructName(externalTypeName).
 		Generate(funcWriter)
 
+	// The internal struct needs JSON marshallers to carry the unknown fields
+	NewTemplater().
+		WithFilename("json_marshallers.template").
+		WithStructName(internalTypeName).
+		Generate(funcWriter)
+
 	// The external struct needs a (private) getter to retrieve internal
 	fmt.Fprintf(funcWriter, "func (s %s) getInternal() any {\n", externalTypeName)
 	fmt.Fprintf(funcWriter, "  return &s.internal\n")
@@ -213,7 +235,12 @@ func generateStruct(filename string, prefix, name string, fields []config.Field,
 	fmt.Fprintf(structWriter, "type %s struct {\n", externalTypeName)
 	fmt.Fprintf(structWriter, "  internal %s\n", internalTypeName)
 	fmt.Fprintf(structWriter, "  regionOptions *RegionOptions\n")
-	fmt.Fprintf(structWriter, "}\n")
+	fmt.Fprintf(structWriter, "}\n\n")
+
+	// Followed by the field registry
+	fmt.Fprintf(infoWriter, "}\n\n")
+	infoWriter.Flush()
+	structWriter.Write(infoBuffer.Bytes())
 
 	// Now that we've completed our structs, we can commit everything into our main writer
 
@@ -246,3 +273,68 @@ func generateStruct(filename string, prefix, name string, fields []config.Field,
 	// Write the file
 	return writeCodeFile(filename, formatted)
 }
+
+// fieldTypeConstants maps specification types to FieldType constant names
+var fieldTypeConstants = map[string]string{
+	"uint64":     "FieldTypeUint64",
+	"int":        "FieldTypeInt",
+	"number":     "FieldTypeNumber",
+	"string":     "FieldTypeString",
+	"uuid":       "FieldTypeUUID",
+	"color_rgba": "FieldTypeColorRGBA",
+	"timestamp":  "FieldTypeTimestamp",
+	"enum":       "FieldTypeEnum",
+	"enum_array": "FieldTypeEnumArray",
+}
+
+// writeFieldInfo writes the registry entry for a field, omitting empty values
+func writeFieldInfo(wr io.Writer, regionName string, field config.Field) {
+	fieldType, ok := fieldTypeConstants[field.Type()]
+	if !ok {
+		panic("Unhanled type " + field.Type())
+	}
+
+	fmt.Fprintf(wr, "  {\n")
+	fmt.Fprintf(wr, "    Region: %q,\n", regionName)
+	fmt.Fprintf(wr, "    Key: %d,\n", field.Key())
+	fmt.Fprintf(wr, "    Name: %q,\n", field.Name())
+	fmt.Fprintf(wr, "    Type: %s,\n", fieldType)
+	if field.HasEnumeration() {
+		fmt.Fprintf(wr, "    Enum: %q,\n", field.GetInternalEnumType())
+	}
+	optional := map[string]string{
+		"Unit":        field.Unit(),
+		"Description": field.Description(),
+	}
+	for _, key := range []string{"Unit", "Description"} {
+		if optional[key] != "" {
+			fmt.Fprintf(wr, "    %s: %q,\n", key, optional[key])
+		}
+	}
+	switch field.Required() {
+	case "true":
+		fmt.Fprintf(wr, "    Requirement: FieldRequired,\n")
+	case "recommended":
+		fmt.Fprintf(wr, "    Requirement: FieldRecommended,\n")
+	}
+	if field.MaxLength() != 0 {
+		fmt.Fprintf(wr, "    MaxLength: %d,\n", field.MaxLength())
+	}
+	if field.IsDeprecated() {
+		fmt.Fprintf(wr, "    Deprecated: true,\n")
+	}
+	if field.ReplacedBy() != "" {
+		fmt.Fprintf(wr, "    ReplacedBy: %q,\n", field.ReplacedBy())
+	}
+	fmt.Fprintf(wr, "  },\n")
+}
+
+// deprecationComment returns the text of a "Deprecated:" doc comment paragraph
+// for a field or enumeration value, naming the replacement where there is one
+func deprecationComment(what, replacedBy string) string {
+	comment := fmt.Sprintf("Deprecated: the specification has deprecated this %s", what)
+	if replacedBy != "" {
+		comment += fmt.Sprintf(", use %s instead", replacedBy)
+	}
+	return comment
+}
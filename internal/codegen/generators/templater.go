@@ -38,6 +38,7 @@ type Templater struct {
 	getterComment string
 	clearComment  string
 	mapName       string
+	varName       string
 }
 
 func NewTemplater() *Templater {
@@ -84,6 +85,11 @@ func (t *Templater) WithMapName(mapName string) *Templater {
 	return t
 }
 
+func (t *Templater) WithVarName(varName string) *Templater {
+	t.varName = varName
+	return t
+}
+
 func (t *Templater) Generate(writer io.Writer) {
 	if t.filename == "" {
 		panic("templater has no filename set")
@@ -100,6 +106,7 @@ func (t *Templater) Generate(writer io.Writer) {
 	datastr = strings.ReplaceAll(datastr, "#GETTER_COMMENT#", t.getterComment)
 	datastr = strings.ReplaceAll(datastr, "#CLEAR_COMMENT#", t.clearComment)
 	datastr = strings.ReplaceAll(datastr, "#MAP#", t.mapName)
+	datastr = strings.ReplaceAll(datastr, "#VAR#", t.varName)
 	datastr = strings.TrimSpace(datastr) + "\n\n"
 	writer.Write([]byte(datastr))
 }
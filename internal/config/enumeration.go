@@ -43,6 +43,7 @@ type enumerationYAML struct {
 	Emoji        string `yaml:"emoji"`
 	Deprecated   bool   `yaml:"deprecated"`
 	Abbreviation string `yaml:"abbreviation"`
+	ReplacedBy   string `yaml:"replaced_by"`
 }
 
 // Enumeration is the public representation of an enumerated set
@@ -86,6 +87,11 @@ func (e Enumeration) IsDeprecated() bool {
 	return e.yaml.Deprecated
 }
 
+// ReplacedBy returns the name of the value replacing a deprecated value, if the specification gives one
+func (e Enumeration) ReplacedBy() string {
+	return e.yaml.ReplacedBy
+}
+
 // Description returns the description of an enum
 func (e Enumeration) Description() string {
 	if str, ok := e.yaml.Description.(string); ok {
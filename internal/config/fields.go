@@ -47,6 +47,7 @@ type fieldYAML struct {
 	Example          string `yaml:"example"`
 	MaxLength        int    `yaml:"max_length"`
 	Deprecated       bool   `yaml:"deprecated"`
+	ReplacedBy       string `yaml:"replaced_by"`
 }
 
 // Field is the public representation of a field
@@ -123,6 +124,11 @@ func (f Field) IsDeprecated() bool {
 	return f.yaml.Deprecated
 }
 
+// ReplacedBy returns the name of the field replacing a deprecated field, if the specification gives one
+func (f Field) ReplacedBy() string {
+	return f.yaml.ReplacedBy
+}
+
 func (f Field) EnumItemsFile() string {
 	return f.yaml.ItemsFile
 }
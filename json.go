@@ -0,0 +1,154 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// ToJSON returns a JSON representation of the tag, using the same document
+// structure and options as ToYAML
+func (o *OpenPrintTag) ToJSON(opts ...YAMLOption) (string, error) {
+	obj := o.prepare(opts...)
+	output, err := json.MarshalIndent(obj, "", "  ")
+	return string(output), err
+}
+
+// FromJSON reads a tag from JSON representation
+// Values for fields with a unit may be given as quantities (see FromYAML)
+func FromJSON(jsonData string) (*OpenPrintTag, error) {
+	document, err := convertJSONQuantities([]byte(jsonData))
+	if err != nil {
+		return nil, err
+	}
+
+	obj := YamlEncoder{}
+	if err := json.Unmarshal(document, &obj); err != nil {
+		return nil, err
+	}
+
+	return reconstruct(obj), nil
+}
+
+// marshalJSONWithUnknowns marshals the known fields of a region, then appends
+// any unknown fields as an "other" object, mirroring the YAML form
+func marshalJSONWithUnknowns(known any, unknowns map[any]any) ([]byte, error) {
+	data, err := json.Marshal(known)
+	if err != nil || len(unknowns) == 0 {
+		return data, err
+	}
+
+	other, err := json.Marshal(toJSONValue(unknowns))
+	if err != nil {
+		return nil, err
+	}
+
+	// Splice "other" into the object, ahead of the closing brace
+	buf := bytes.NewBuffer(nil)
+	buf.Write(data[:len(data)-1])
+	if len(data) > 2 {
+		buf.WriteByte(',')
+	}
+	buf.WriteString(`"other":`)
+	buf.Write(other)
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// unmarshalJSONWithUnknowns unmarshals the known fields of a region into known
+// and returns the content of the "other" object as unknown fields
+func unmarshalJSONWithUnknowns(data []byte, known any) (map[any]any, error) {
+	if err := json.Unmarshal(data, known); err != nil {
+		return nil, err
+	}
+
+	var wrapper struct {
+		Other map[string]any `json:"other"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&wrapper); err != nil {
+		return nil, err
+	}
+	if len(wrapper.Other) == 0 {
+		return nil, nil
+	}
+	return fromJSONValue(wrapper.Other).(map[any]any), nil
+}
+
+// toJSONValue converts unknown field values into a form the JSON encoder accepts,
+// JSON object keys must be strings so keys are rendered as they would be in YAML
+func toJSONValue(value any) any {
+	switch x := value.(type) {
+	case map[any]any:
+		converted := make(map[string]any, len(x))
+		for key, item := range x {
+			converted[fmt.Sprintf("%v", key)] = toJSONValue(item)
+		}
+		return converted
+	case []any:
+		converted := make([]any, len(x))
+		for idx, item := range x {
+			converted[idx] = toJSONValue(item)
+		}
+		return converted
+	default:
+		return value
+	}
+}
+
+// fromJSONValue reverses toJSONValue, restoring integer keys and values to
+// the types produced by the CBOR decoder (uint64, or int64 where negative)
+func fromJSONValue(value any) any {
+	switch x := value.(type) {
+	case map[string]any:
+		converted := make(map[any]any, len(x))
+		for key, item := range x {
+			if intKey, err := strconv.ParseUint(key, 10, 64); err == nil {
+				converted[intKey] = fromJSONValue(item)
+			} else {
+				converted[key] = fromJSONValue(item)
+			}
+		}
+		return converted
+	case []any:
+		converted := make([]any, len(x))
+		for idx, item := range x {
+			converted[idx] = fromJSONValue(item)
+		}
+		return converted
+	case json.Number:
+		if intValue, err := strconv.ParseUint(string(x), 10, 64); err == nil {
+			return intValue
+		}
+		if intValue, err := strconv.ParseInt(string(x), 10, 64); err == nil {
+			return intValue
+		}
+		floatValue, _ := x.Float64()
+		return floatValue
+	default:
+		return value
+	}
+}
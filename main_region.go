@@ -30,63 +30,63 @@ import (
 )
 
 type mainInternal struct {
-	InstanceUuid                *uuid.UUID                `cbor:"0,keyasint,omitempty" yaml:"instance_uuid,omitempty" opt:"name=instance_uuid,key=0"`
-	PackageUuid                 *uuid.UUID                `cbor:"1,keyasint,omitempty" yaml:"package_uuid,omitempty" opt:"name=package_uuid,key=1"`
-	MaterialUuid                *uuid.UUID                `cbor:"2,keyasint,omitempty" yaml:"material_uuid,omitempty" opt:"name=material_uuid,key=2"`
-	BrandUuid                   *uuid.UUID                `cbor:"3,keyasint,omitempty" yaml:"brand_uuid,omitempty" opt:"name=brand_uuid,key=3"`
-	Gtin                        *uint64                   `cbor:"4,keyasint,omitempty" yaml:"gtin,omitempty" opt:"name=gtin,key=4,recommended"`
-	BrandSpecificInstanceId     *string                   `cbor:"5,keyasint,omitempty" yaml:"brand_specific_instance_id,omitempty" opt:"name=brand_specific_instance_id,key=5,max_length=16"`
-	BrandSpecificPackageId      *string                   `cbor:"6,keyasint,omitempty" yaml:"brand_specific_package_id,omitempty" opt:"name=brand_specific_package_id,key=6,max_length=16"`
-	BrandSpecificMaterialId     *string                   `cbor:"7,keyasint,omitempty" yaml:"brand_specific_material_id,omitempty" opt:"name=brand_specific_material_id,key=7,max_length=16"`
-	MaterialClass               *MaterialClass            `cbor:"8,keyasint,omitempty" yaml:"material_class,omitempty" opt:"name=material_class,key=8,required"`
-	MaterialType                *MaterialType             `cbor:"9,keyasint,omitempty" yaml:"material_type,omitempty" opt:"name=material_type,key=9,recommended"`
-	MaterialName                *string                   `cbor:"10,keyasint,omitempty" yaml:"material_name,omitempty" opt:"name=material_name,key=10,recommended,max_length=31"`
-	MaterialAbbreviation        *string                   `cbor:"52,keyasint,omitempty" yaml:"material_abbreviation,omitempty" opt:"name=material_abbreviation,key=52,max_length=7"`
-	BrandName                   *string                   `cbor:"11,keyasint,omitempty" yaml:"brand_name,omitempty" opt:"name=brand_name,key=11,recommended,max_length=31"`
-	WriteProtection             *WriteProtection          `cbor:"13,keyasint,omitempty" yaml:"write_protection,omitempty" opt:"name=write_protection,key=13"`
-	ManufacturedDate            *uint64                   `cbor:"14,keyasint,omitempty" yaml:"manufactured_date,omitempty" opt:"name=manufactured_date,key=14,recommended"`
-	CountryOfOrigin             *string                   `cbor:"55,keyasint,omitempty" yaml:"country_of_origin,omitempty" opt:"name=country_of_origin,key=55,max_length=2"`
-	ExpirationDate              *uint64                   `cbor:"15,keyasint,omitempty" yaml:"expiration_date,omitempty" opt:"name=expiration_date,key=15"`
-	NominalNettoFullWeight      *float64                  `cbor:"16,keyasint,omitempty" yaml:"nominal_netto_full_weight,omitempty" opt:"name=nominal_netto_full_weight,key=16,recommended"`
-	ActualNettoFullWeight       *float64                  `cbor:"17,keyasint,omitempty" yaml:"actual_netto_full_weight,omitempty" opt:"name=actual_netto_full_weight,key=17,recommended"`
-	NominalFullLength           *float64                  `cbor:"53,keyasint,omitempty" yaml:"nominal_full_length,omitempty" opt:"name=nominal_full_length,key=53,recommended"`
-	ActualFullLength            *float64                  `cbor:"54,keyasint,omitempty" yaml:"actual_full_length,omitempty" opt:"name=actual_full_length,key=54,recommended"`
-	EmptyContainerWeight        *float64                  `cbor:"18,keyasint,omitempty" yaml:"empty_container_weight,omitempty" opt:"name=empty_container_weight,key=18,recommended"`
-	PrimaryColor                *ColorRGBA                `cbor:"19,keyasint,omitempty" yaml:"primary_color,flow,omitempty" opt:"name=primary_color,key=19,recommended,rgba"`
-	SecondaryColor0             *ColorRGBA                `cbor:"20,keyasint,omitempty" yaml:"secondary_color_0,flow,omitempty" opt:"name=secondary_color_0,key=20,rgba"`
-	SecondaryColor1             *ColorRGBA                `cbor:"21,keyasint,omitempty" yaml:"secondary_color_1,flow,omitempty" opt:"name=secondary_color_1,key=21,rgba"`
-	SecondaryColor2             *ColorRGBA                `cbor:"22,keyasint,omitempty" yaml:"secondary_color_2,flow,omitempty" opt:"name=secondary_color_2,key=22,rgba"`
-	SecondaryColor3             *ColorRGBA                `cbor:"23,keyasint,omitempty" yaml:"secondary_color_3,flow,omitempty" opt:"name=secondary_color_3,key=23,rgba"`
-	SecondaryColor4             *ColorRGBA                `cbor:"24,keyasint,omitempty" yaml:"secondary_color_4,flow,omitempty" opt:"name=secondary_color_4,key=24,rgba"`
-	TransmissionDistance        *float64                  `cbor:"27,keyasint,omitempty" yaml:"transmission_distance,omitempty" opt:"name=transmission_distance,key=27"`
-	Tags                        *[]Tag                    `cbor:"28,keyasint,omitempty" yaml:"tags,omitempty" opt:"name=tags,key=28,recommended,max_length=16"`
-	Certifications              *[]MaterialCertifications `cbor:"56,keyasint,omitempty" yaml:"certifications,omitempty" opt:"name=certifications,key=56,max_length=8"`
-	Density                     *float64                  `cbor:"29,keyasint,omitempty" yaml:"density,omitempty" opt:"name=density,key=29,recommended"`
-	FilamentDiameter            *float64                  `cbor:"30,keyasint,omitempty" yaml:"filament_diameter,omitempty" opt:"name=filament_diameter,key=30"`
-	ShoreHardnessA              *int                      `cbor:"31,keyasint,omitempty" yaml:"shore_hardness_a,omitempty" opt:"name=shore_hardness_a,key=31"`
-	ShoreHardnessD              *int                      `cbor:"32,keyasint,omitempty" yaml:"shore_hardness_d,omitempty" opt:"name=shore_hardness_d,key=32"`
-	MinNozzleDiameter           *float64                  `cbor:"33,keyasint,omitempty" yaml:"min_nozzle_diameter,omitempty" opt:"name=min_nozzle_diameter,key=33"`
-	MinPrintTemperature         *int                      `cbor:"34,keyasint,omitempty" yaml:"min_print_temperature,omitempty" opt:"name=min_print_temperature,key=34,recommended"`
-	MaxPrintTemperature         *int                      `cbor:"35,keyasint,omitempty" yaml:"max_print_temperature,omitempty" opt:"name=max_print_temperature,key=35,recommended"`
-	PreheatTemperature          *int                      `cbor:"36,keyasint,omitempty" yaml:"preheat_temperature,omitempty" opt:"name=preheat_temperature,key=36,recommended"`
-	MinBedTemperature           *int                      `cbor:"37,keyasint,omitempty" yaml:"min_bed_temperature,omitempty" opt:"name=min_bed_temperature,key=37,recommended"`
-	MaxBedTemperature           *int                      `cbor:"38,keyasint,omitempty" yaml:"max_bed_temperature,omitempty" opt:"name=max_bed_temperature,key=38,recommended"`
-	MinChamberTemperature       *int                      `cbor:"39,keyasint,omitempty" yaml:"min_chamber_temperature,omitempty" opt:"name=min_chamber_temperature,key=39"`
-	MaxChamberTemperature       *int                      `cbor:"40,keyasint,omitempty" yaml:"max_chamber_temperature,omitempty" opt:"name=max_chamber_temperature,key=40"`
-	ChamberTemperature          *int                      `cbor:"41,keyasint,omitempty" yaml:"chamber_temperature,omitempty" opt:"name=chamber_temperature,key=41"`
-	ContainerWidth              *int                      `cbor:"42,keyasint,omitempty" yaml:"container_width,omitempty" opt:"name=container_width,key=42"`
-	ContainerOuterDiameter      *int                      `cbor:"43,keyasint,omitempty" yaml:"container_outer_diameter,omitempty" opt:"name=container_outer_diameter,key=43"`
-	ContainerInnerDiameter      *int                      `cbor:"44,keyasint,omitempty" yaml:"container_inner_diameter,omitempty" opt:"name=container_inner_diameter,key=44"`
-	ContainerHoleDiameter       *int                      `cbor:"45,keyasint,omitempty" yaml:"container_hole_diameter,omitempty" opt:"name=container_hole_diameter,key=45"`
-	Viscosity18C                *float64                  `cbor:"46,keyasint,omitempty" yaml:"viscosity_18c,omitempty" opt:"name=viscosity_18c,key=46"`
-	Viscosity25C                *float64                  `cbor:"47,keyasint,omitempty" yaml:"viscosity_25c,omitempty" opt:"name=viscosity_25c,key=47"`
-	Viscosity40C                *float64                  `cbor:"48,keyasint,omitempty" yaml:"viscosity_40c,omitempty" opt:"name=viscosity_40c,key=48"`
-	Viscosity60C                *float64                  `cbor:"49,keyasint,omitempty" yaml:"viscosity_60c,omitempty" opt:"name=viscosity_60c,key=49"`
-	ContainerVolumetricCapacity *float64                  `cbor:"50,keyasint,omitempty" yaml:"container_volumetric_capacity,omitempty" opt:"name=container_volumetric_capacity,key=50"`
-	CureWavelength              *int                      `cbor:"51,keyasint,omitempty" yaml:"cure_wavelength,omitempty" opt:"name=cure_wavelength,key=51"`
-	DryingTemperature           *int                      `cbor:"57,keyasint,omitempty" yaml:"drying_temperature,omitempty" opt:"name=drying_temperature,key=57"`
-	DryingTime                  *int                      `cbor:"58,keyasint,omitempty" yaml:"drying_time,omitempty" opt:"name=drying_time,key=58"`
-	Unknowns                    map[any]any               `cbor:"-" yaml:"other,omitempty"`
+	InstanceUuid                *uuid.UUID                `cbor:"0,keyasint,omitempty" yaml:"instance_uuid,omitempty" json:"instance_uuid,omitempty" opt:"name=instance_uuid,key=0"`
+	PackageUuid                 *uuid.UUID                `cbor:"1,keyasint,omitempty" yaml:"package_uuid,omitempty" json:"package_uuid,omitempty" opt:"name=package_uuid,key=1"`
+	MaterialUuid                *uuid.UUID                `cbor:"2,keyasint,omitempty" yaml:"material_uuid,omitempty" json:"material_uuid,omitempty" opt:"name=material_uuid,key=2"`
+	BrandUuid                   *uuid.UUID                `cbor:"3,keyasint,omitempty" yaml:"brand_uuid,omitempty" json:"brand_uuid,omitempty" opt:"name=brand_uuid,key=3"`
+	Gtin                        *uint64                   `cbor:"4,keyasint,omitempty" yaml:"gtin,omitempty" json:"gtin,omitempty" opt:"name=gtin,key=4,recommended"`
+	BrandSpecificInstanceId     *string                   `cbor:"5,keyasint,omitempty" yaml:"brand_specific_instance_id,omitempty" json:"brand_specific_instance_id,omitempty" opt:"name=brand_specific_instance_id,key=5,max_length=16"`
+	BrandSpecificPackageId      *string                   `cbor:"6,keyasint,omitempty" yaml:"brand_specific_package_id,omitempty" json:"brand_specific_package_id,omitempty" opt:"name=brand_specific_package_id,key=6,max_length=16"`
+	BrandSpecificMaterialId     *string                   `cbor:"7,keyasint,omitempty" yaml:"brand_specific_material_id,omitempty" json:"brand_specific_material_id,omitempty" opt:"name=brand_specific_material_id,key=7,max_length=16"`
+	MaterialClass               *MaterialClass            `cbor:"8,keyasint,omitempty" yaml:"material_class,omitempty" json:"material_class,omitempty" opt:"name=material_class,key=8,required"`
+	MaterialType                *MaterialType             `cbor:"9,keyasint,omitempty" yaml:"material_type,omitempty" json:"material_type,omitempty" opt:"name=material_type,key=9,recommended"`
+	MaterialName                *string                   `cbor:"10,keyasint,omitempty" yaml:"material_name,omitempty" json:"material_name,omitempty" opt:"name=material_name,key=10,recommended,max_length=31"`
+	MaterialAbbreviation        *string                   `cbor:"52,keyasint,omitempty" yaml:"material_abbreviation,omitempty" json:"material_abbreviation,omitempty" opt:"name=material_abbreviation,key=52,max_length=7"`
+	BrandName                   *string                   `cbor:"11,keyasint,omitempty" yaml:"brand_name,omitempty" json:"brand_name,omitempty" opt:"name=brand_name,key=11,recommended,max_length=31"`
+	WriteProtection             *WriteProtection          `cbor:"13,keyasint,omitempty" yaml:"write_protection,omitempty" json:"write_protection,omitempty" opt:"name=write_protection,key=13"`
+	ManufacturedDate            *uint64                   `cbor:"14,keyasint,omitempty" yaml:"manufactured_date,omitempty" json:"manufactured_date,omitempty" opt:"name=manufactured_date,key=14,recommended"`
+	CountryOfOrigin             *string                   `cbor:"55,keyasint,omitempty" yaml:"country_of_origin,omitempty" json:"country_of_origin,omitempty" opt:"name=country_of_origin,key=55,max_length=2"`
+	ExpirationDate              *uint64                   `cbor:"15,keyasint,omitempty" yaml:"expiration_date,omitempty" json:"expiration_date,omitempty" opt:"name=expiration_date,key=15"`
+	NominalNettoFullWeight      *float64                  `cbor:"16,keyasint,omitempty" yaml:"nominal_netto_full_weight,omitempty" json:"nominal_netto_full_weight,omitempty" opt:"name=nominal_netto_full_weight,key=16,recommended"`
+	ActualNettoFullWeight       *float64                  `cbor:"17,keyasint,omitempty" yaml:"actual_netto_full_weight,omitempty" json:"actual_netto_full_weight,omitempty" opt:"name=actual_netto_full_weight,key=17,recommended"`
+	NominalFullLength           *float64                  `cbor:"53,keyasint,omitempty" yaml:"nominal_full_length,omitempty" json:"nominal_full_length,omitempty" opt:"name=nominal_full_length,key=53,recommended"`
+	ActualFullLength            *float64                  `cbor:"54,keyasint,omitempty" yaml:"actual_full_length,omitempty" json:"actual_full_length,omitempty" opt:"name=actual_full_length,key=54,recommended"`
+	EmptyContainerWeight        *float64                  `cbor:"18,keyasint,omitempty" yaml:"empty_container_weight,omitempty" json:"empty_container_weight,omitempty" opt:"name=empty_container_weight,key=18,recommended"`
+	PrimaryColor                *ColorRGBA                `cbor:"19,keyasint,omitempty" yaml:"primary_color,flow,omitempty" json:"primary_color,omitempty" opt:"name=primary_color,key=19,recommended,rgba"`
+	SecondaryColor0             *ColorRGBA                `cbor:"20,keyasint,omitempty" yaml:"secondary_color_0,flow,omitempty" json:"secondary_color_0,omitempty" opt:"name=secondary_color_0,key=20,rgba"`
+	SecondaryColor1             *ColorRGBA                `cbor:"21,keyasint,omitempty" yaml:"secondary_color_1,flow,omitempty" json:"secondary_color_1,omitempty" opt:"name=secondary_color_1,key=21,rgba"`
+	SecondaryColor2             *ColorRGBA                `cbor:"22,keyasint,omitempty" yaml:"secondary_color_2,flow,omitempty" json:"secondary_color_2,omitempty" opt:"name=secondary_color_2,key=22,rgba"`
+	SecondaryColor3             *ColorRGBA                `cbor:"23,keyasint,omitempty" yaml:"secondary_color_3,flow,omitempty" json:"secondary_color_3,omitempty" opt:"name=secondary_color_3,key=23,rgba"`
+	SecondaryColor4             *ColorRGBA                `cbor:"24,keyasint,omitempty" yaml:"secondary_color_4,flow,omitempty" json:"secondary_color_4,omitempty" opt:"name=secondary_color_4,key=24,rgba"`
+	TransmissionDistance        *float64                  `cbor:"27,keyasint,omitempty" yaml:"transmission_distance,omitempty" json:"transmission_distance,omitempty" opt:"name=transmission_distance,key=27"`
+	Tags                        *[]Tag                    `cbor:"28,keyasint,omitempty" yaml:"tags,omitempty" json:"tags,omitempty" opt:"name=tags,key=28,recommended,max_length=16"`
+	Certifications              *[]MaterialCertifications `cbor:"56,keyasint,omitempty" yaml:"certifications,omitempty" json:"certifications,omitempty" opt:"name=certifications,key=56,max_length=8"`
+	Density                     *float64                  `cbor:"29,keyasint,omitempty" yaml:"density,omitempty" json:"density,omitempty" opt:"name=density,key=29,recommended"`
+	FilamentDiameter            *float64                  `cbor:"30,keyasint,omitempty" yaml:"filament_diameter,omitempty" json:"filament_diameter,omitempty" opt:"name=filament_diameter,key=30"`
+	ShoreHardnessA              *int                      `cbor:"31,keyasint,omitempty" yaml:"shore_hardness_a,omitempty" json:"shore_hardness_a,omitempty" opt:"name=shore_hardness_a,key=31"`
+	ShoreHardnessD              *int                      `cbor:"32,keyasint,omitempty" yaml:"shore_hardness_d,omitempty" json:"shore_hardness_d,omitempty" opt:"name=shore_hardness_d,key=32"`
+	MinNozzleDiameter           *float64                  `cbor:"33,keyasint,omitempty" yaml:"min_nozzle_diameter,omitempty" json:"min_nozzle_diameter,omitempty" opt:"name=min_nozzle_diameter,key=33"`
+	MinPrintTemperature         *int                      `cbor:"34,keyasint,omitempty" yaml:"min_print_temperature,omitempty" json:"min_print_temperature,omitempty" opt:"name=min_print_temperature,key=34,recommended"`
+	MaxPrintTemperature         *int                      `cbor:"35,keyasint,omitempty" yaml:"max_print_temperature,omitempty" json:"max_print_temperature,omitempty" opt:"name=max_print_temperature,key=35,recommended"`
+	PreheatTemperature          *int                      `cbor:"36,keyasint,omitempty" yaml:"preheat_temperature,omitempty" json:"preheat_temperature,omitempty" opt:"name=preheat_temperature,key=36,recommended"`
+	MinBedTemperature           *int                      `cbor:"37,keyasint,omitempty" yaml:"min_bed_temperature,omitempty" json:"min_bed_temperature,omitempty" opt:"name=min_bed_temperature,key=37,recommended"`
+	MaxBedTemperature           *int                      `cbor:"38,keyasint,omitempty" yaml:"max_bed_temperature,omitempty" json:"max_bed_temperature,omitempty" opt:"name=max_bed_temperature,key=38,recommended"`
+	MinChamberTemperature       *int                      `cbor:"39,keyasint,omitempty" yaml:"min_chamber_temperature,omitempty" json:"min_chamber_temperature,omitempty" opt:"name=min_chamber_temperature,key=39"`
+	MaxChamberTemperature       *int                      `cbor:"40,keyasint,omitempty" yaml:"max_chamber_temperature,omitempty" json:"max_chamber_temperature,omitempty" opt:"name=max_chamber_temperature,key=40"`
+	ChamberTemperature          *int                      `cbor:"41,keyasint,omitempty" yaml:"chamber_temperature,omitempty" json:"chamber_temperature,omitempty" opt:"name=chamber_temperature,key=41"`
+	ContainerWidth              *int                      `cbor:"42,keyasint,omitempty" yaml:"container_width,omitempty" json:"container_width,omitempty" opt:"name=container_width,key=42"`
+	ContainerOuterDiameter      *int                      `cbor:"43,keyasint,omitempty" yaml:"container_outer_diameter,omitempty" json:"container_outer_diameter,omitempty" opt:"name=container_outer_diameter,key=43"`
+	ContainerInnerDiameter      *int                      `cbor:"44,keyasint,omitempty" yaml:"container_inner_diameter,omitempty" json:"container_inner_diameter,omitempty" opt:"name=container_inner_diameter,key=44"`
+	ContainerHoleDiameter       *int                      `cbor:"45,keyasint,omitempty" yaml:"container_hole_diameter,omitempty" json:"container_hole_diameter,omitempty" opt:"name=container_hole_diameter,key=45"`
+	Viscosity18C                *float64                  `cbor:"46,keyasint,omitempty" yaml:"viscosity_18c,omitempty" json:"viscosity_18c,omitempty" opt:"name=viscosity_18c,key=46"`
+	Viscosity25C                *float64                  `cbor:"47,keyasint,omitempty" yaml:"viscosity_25c,omitempty" json:"viscosity_25c,omitempty" opt:"name=viscosity_25c,key=47"`
+	Viscosity40C                *float64                  `cbor:"48,keyasint,omitempty" yaml:"viscosity_40c,omitempty" json:"viscosity_40c,omitempty" opt:"name=viscosity_40c,key=48"`
+	Viscosity60C                *float64                  `cbor:"49,keyasint,omitempty" yaml:"viscosity_60c,omitempty" json:"viscosity_60c,omitempty" opt:"name=viscosity_60c,key=49"`
+	ContainerVolumetricCapacity *float64                  `cbor:"50,keyasint,omitempty" yaml:"container_volumetric_capacity,omitempty" json:"container_volumetric_capacity,omitempty" opt:"name=container_volumetric_capacity,key=50"`
+	CureWavelength              *int                      `cbor:"51,keyasint,omitempty" yaml:"cure_wavelength,omitempty" json:"cure_wavelength,omitempty" opt:"name=cure_wavelength,key=51"`
+	DryingTemperature           *int                      `cbor:"57,keyasint,omitempty" yaml:"drying_temperature,omitempty" json:"drying_temperature,omitempty" opt:"name=drying_temperature,key=57"`
+	DryingTime                  *int                      `cbor:"58,keyasint,omitempty" yaml:"drying_time,omitempty" json:"drying_time,omitempty" opt:"name=drying_time,key=58"`
+	Unknowns                    map[any]any               `cbor:"-" yaml:"other,omitempty" json:"-"`
 }
 
 type MainRegion struct {
@@ -94,6 +94,423 @@ type MainRegion struct {
 	regionOptions *RegionOptions
 }
 
+// mainFieldInfo lists all main region fields defined by the specification
+var mainFieldInfo = []FieldInfo{
+	{
+		Region: "main",
+		Key:    0,
+		Name:   "instance_uuid",
+		Type:   FieldTypeUUID,
+	},
+	{
+		Region: "main",
+		Key:    1,
+		Name:   "package_uuid",
+		Type:   FieldTypeUUID,
+	},
+	{
+		Region: "main",
+		Key:    2,
+		Name:   "material_uuid",
+		Type:   FieldTypeUUID,
+	},
+	{
+		Region: "main",
+		Key:    3,
+		Name:   "brand_uuid",
+		Type:   FieldTypeUUID,
+	},
+	{
+		Region:      "main",
+		Key:         4,
+		Name:        "gtin",
+		Type:        FieldTypeUint64,
+		Description: "Global Trade Item Number.",
+		Requirement: FieldRecommended,
+	},
+	{
+		Region:    "main",
+		Key:       5,
+		Name:      "brand_specific_instance_id",
+		Type:      FieldTypeString,
+		MaxLength: 16,
+	},
+	{
+		Region:    "main",
+		Key:       6,
+		Name:      "brand_specific_package_id",
+		Type:      FieldTypeString,
+		MaxLength: 16,
+	},
+	{
+		Region:    "main",
+		Key:       7,
+		Name:      "brand_specific_material_id",
+		Type:      FieldTypeString,
+		MaxLength: 16,
+	},
+	{
+		Region:      "main",
+		Key:         8,
+		Name:        "material_class",
+		Type:        FieldTypeEnum,
+		Enum:        "MaterialClass",
+		Requirement: FieldRequired,
+	},
+	{
+		Region:      "main",
+		Key:         9,
+		Name:        "material_type",
+		Type:        FieldTypeEnum,
+		Enum:        "MaterialType",
+		Requirement: FieldRecommended,
+	},
+	{
+		Region:      "main",
+		Key:         10,
+		Name:        "material_name",
+		Type:        FieldTypeString,
+		Requirement: FieldRecommended,
+		MaxLength:   31,
+	},
+	{
+		Region:    "main",
+		Key:       52,
+		Name:      "material_abbreviation",
+		Type:      FieldTypeString,
+		MaxLength: 7,
+	},
+	{
+		Region:      "main",
+		Key:         11,
+		Name:        "brand_name",
+		Type:        FieldTypeString,
+		Description: "Brand of the material.",
+		Requirement: FieldRecommended,
+		MaxLength:   31,
+	},
+	{
+		Region: "main",
+		Key:    13,
+		Name:   "write_protection",
+		Type:   FieldTypeEnum,
+		Enum:   "WriteProtection",
+	},
+	{
+		Region:      "main",
+		Key:         14,
+		Name:        "manufactured_date",
+		Type:        FieldTypeTimestamp,
+		Requirement: FieldRecommended,
+	},
+	{
+		Region:      "main",
+		Key:         55,
+		Name:        "country_of_origin",
+		Type:        FieldTypeString,
+		Description: "Country the [MaterialPackageInstance](terminology) was produced in, encoded as a two-letter code according to [ISO 3166-1 alpha-2](https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2).",
+		MaxLength:   2,
+	},
+	{
+		Region: "main",
+		Key:    15,
+		Name:   "expiration_date",
+		Type:   FieldTypeTimestamp,
+	},
+	{
+		Region:      "main",
+		Key:         16,
+		Name:        "nominal_netto_full_weight",
+		Type:        FieldTypeNumber,
+		Unit:        "g",
+		Requirement: FieldRecommended,
+	},
+	{
+		Region:      "main",
+		Key:         17,
+		Name:        "actual_netto_full_weight",
+		Type:        FieldTypeNumber,
+		Unit:        "g",
+		Requirement: FieldRecommended,
+	},
+	{
+		Region:      "main",
+		Key:         53,
+		Name:        "nominal_full_length",
+		Type:        FieldTypeNumber,
+		Unit:        "mm",
+		Requirement: FieldRecommended,
+	},
+	{
+		Region:      "main",
+		Key:         54,
+		Name:        "actual_full_length",
+		Type:        FieldTypeNumber,
+		Unit:        "mm",
+		Requirement: FieldRecommended,
+	},
+	{
+		Region:      "main",
+		Key:         18,
+		Name:        "empty_container_weight",
+		Type:        FieldTypeNumber,
+		Unit:        "g",
+		Description: "Weight of the empty container.",
+		Requirement: FieldRecommended,
+	},
+	{
+		Region:      "main",
+		Key:         19,
+		Name:        "primary_color",
+		Type:        FieldTypeColorRGBA,
+		Requirement: FieldRecommended,
+	},
+	{
+		Region: "main",
+		Key:    20,
+		Name:   "secondary_color_0",
+		Type:   FieldTypeColorRGBA,
+	},
+	{
+		Region:      "main",
+		Key:         21,
+		Name:        "secondary_color_1",
+		Type:        FieldTypeColorRGBA,
+		Description: "See `secondary_color_0`.",
+	},
+	{
+		Region:      "main",
+		Key:         22,
+		Name:        "secondary_color_2",
+		Type:        FieldTypeColorRGBA,
+		Description: "See `secondary_color_0`.",
+	},
+	{
+		Region:      "main",
+		Key:         23,
+		Name:        "secondary_color_3",
+		Type:        FieldTypeColorRGBA,
+		Description: "See `secondary_color_0`.",
+	},
+	{
+		Region:      "main",
+		Key:         24,
+		Name:        "secondary_color_4",
+		Type:        FieldTypeColorRGBA,
+		Description: "See `secondary_color_0`.",
+	},
+	{
+		Region: "main",
+		Key:    27,
+		Name:   "transmission_distance",
+		Type:   FieldTypeNumber,
+	},
+	{
+		Region:      "main",
+		Key:         28,
+		Name:        "tags",
+		Type:        FieldTypeEnumArray,
+		Enum:        "Tag",
+		Description: "Properties of the material. Can have multiple tags at once.",
+		Requirement: FieldRecommended,
+		MaxLength:   16,
+	},
+	{
+		Region:      "main",
+		Key:         56,
+		Name:        "certifications",
+		Type:        FieldTypeEnumArray,
+		Enum:        "MaterialCertifications",
+		Description: "Certifications the material has.",
+		MaxLength:   8,
+	},
+	{
+		Region:      "main",
+		Key:         29,
+		Name:        "density",
+		Type:        FieldTypeNumber,
+		Unit:        "g/cm³",
+		Description: "Density of the material.",
+		Requirement: FieldRecommended,
+	},
+	{
+		Region: "main",
+		Key:    30,
+		Name:   "filament_diameter",
+		Type:   FieldTypeNumber,
+		Unit:   "mm",
+	},
+	{
+		Region: "main",
+		Key:    31,
+		Name:   "shore_hardness_a",
+		Type:   FieldTypeInt,
+	},
+	{
+		Region: "main",
+		Key:    32,
+		Name:   "shore_hardness_d",
+		Type:   FieldTypeInt,
+	},
+	{
+		Region: "main",
+		Key:    33,
+		Name:   "min_nozzle_diameter",
+		Type:   FieldTypeNumber,
+		Unit:   "mm",
+	},
+	{
+		Region:      "main",
+		Key:         34,
+		Name:        "min_print_temperature",
+		Type:        FieldTypeInt,
+		Unit:        "°C",
+		Requirement: FieldRecommended,
+	},
+	{
+		Region:      "main",
+		Key:         35,
+		Name:        "max_print_temperature",
+		Type:        FieldTypeInt,
+		Unit:        "°C",
+		Requirement: FieldRecommended,
+	},
+	{
+		Region:      "main",
+		Key:         36,
+		Name:        "preheat_temperature",
+		Type:        FieldTypeInt,
+		Unit:        "°C",
+		Requirement: FieldRecommended,
+	},
+	{
+		Region:      "main",
+		Key:         37,
+		Name:        "min_bed_temperature",
+		Type:        FieldTypeInt,
+		Unit:        "°C",
+		Requirement: FieldRecommended,
+	},
+	{
+		Region:      "main",
+		Key:         38,
+		Name:        "max_bed_temperature",
+		Type:        FieldTypeInt,
+		Unit:        "°C",
+		Requirement: FieldRecommended,
+	},
+	{
+		Region: "main",
+		Key:    39,
+		Name:   "min_chamber_temperature",
+		Type:   FieldTypeInt,
+		Unit:   "°C",
+	},
+	{
+		Region: "main",
+		Key:    40,
+		Name:   "max_chamber_temperature",
+		Type:   FieldTypeInt,
+		Unit:   "°C",
+	},
+	{
+		Region: "main",
+		Key:    41,
+		Name:   "chamber_temperature",
+		Type:   FieldTypeInt,
+		Unit:   "°C",
+	},
+	{
+		Region: "main",
+		Key:    42,
+		Name:   "container_width",
+		Type:   FieldTypeInt,
+		Unit:   "mm",
+	},
+	{
+		Region: "main",
+		Key:    43,
+		Name:   "container_outer_diameter",
+		Type:   FieldTypeInt,
+		Unit:   "mm",
+	},
+	{
+		Region: "main",
+		Key:    44,
+		Name:   "container_inner_diameter",
+		Type:   FieldTypeInt,
+		Unit:   "mm",
+	},
+	{
+		Region: "main",
+		Key:    45,
+		Name:   "container_hole_diameter",
+		Type:   FieldTypeInt,
+		Unit:   "mm",
+	},
+	{
+		Region:      "main",
+		Key:         46,
+		Name:        "viscosity_18c",
+		Type:        FieldTypeNumber,
+		Unit:        "mPa·s",
+		Description: "Viscosity of the material at 18 °C.",
+	},
+	{
+		Region:      "main",
+		Key:         47,
+		Name:        "viscosity_25c",
+		Type:        FieldTypeNumber,
+		Unit:        "mPa·s",
+		Description: "Viscosity of the material at 25 °C.",
+	},
+	{
+		Region:      "main",
+		Key:         48,
+		Name:        "viscosity_40c",
+		Type:        FieldTypeNumber,
+		Unit:        "mPa·s",
+		Description: "Viscosity of the material at 40 °C.",
+	},
+	{
+		Region:      "main",
+		Key:         49,
+		Name:        "viscosity_60c",
+		Type:        FieldTypeNumber,
+		Unit:        "mPa·s",
+		Description: "Viscosity of the material at 60 °C.",
+	},
+	{
+		Region:      "main",
+		Key:         50,
+		Name:        "container_volumetric_capacity",
+		Type:        FieldTypeNumber,
+		Unit:        "ml",
+		Description: "Maximum amount of material the container can hold.",
+	},
+	{
+		Region: "main",
+		Key:    51,
+		Name:   "cure_wavelength",
+		Type:   FieldTypeInt,
+		Unit:   "nm",
+	},
+	{
+		Region: "main",
+		Key:    57,
+		Name:   "drying_temperature",
+		Type:   FieldTypeInt,
+		Unit:   "°C",
+	},
+	{
+		Region: "main",
+		Key:    58,
+		Name:   "drying_time",
+		Type:   FieldTypeInt,
+		Unit:   "min",
+	},
+}
+
 // SetInstanceUuid Sets the value of instance_uuid (0)
 func (s *MainRegion) SetInstanceUuid(value uuid.UUID) *MainRegion {
 	s.internal.InstanceUuid = &value
@@ -453,12 +870,14 @@ func (s *MainRegion) ClearExpirationDate() *MainRegion {
 }
 
 // SetNominalNettoFullWeight Sets the value of nominal_netto_full_weight (16)
+// Unit: g
 func (s *MainRegion) SetNominalNettoFullWeight(value float64) *MainRegion {
 	s.internal.NominalNettoFullWeight = &value
 	return s
 }
 
 // GetNominalNettoFullWeight Gets the value of nominal_netto_full_weight (16)
+// Unit: g
 func (s *MainRegion) GetNominalNettoFullWeight() (float64, bool) {
 	if s.internal.NominalNettoFullWeight != nil {
 		return *s.internal.NominalNettoFullWeight, true
@@ -467,18 +886,21 @@ func (s *MainRegion) GetNominalNettoFullWeight() (float64, bool) {
 }
 
 // ClearNominalNettoFullWeight Clears the value of nominal_netto_full_weight (16)
+// Unit: g
 func (s *MainRegion) ClearNominalNettoFullWeight() *MainRegion {
 	s.internal.NominalNettoFullWeight = nil
 	return s
 }
 
 // SetActualNettoFullWeight Sets the value of actual_netto_full_weight (17)
+// Unit: g
 func (s *MainRegion) SetActualNettoFullWeight(value float64) *MainRegion {
 	s.internal.ActualNettoFullWeight = &value
 	return s
 }
 
 // GetActualNettoFullWeight Gets the value of actual_netto_full_weight (17)
+// Unit: g
 func (s *MainRegion) GetActualNettoFullWeight() (float64, bool) {
 	if s.internal.ActualNettoFullWeight != nil {
 		return *s.internal.ActualNettoFullWeight, true
@@ -487,18 +909,21 @@ func (s *MainRegion) GetActualNettoFullWeight() (float64, bool) {
 }
 
 // ClearActualNettoFullWeight Clears the value of actual_netto_full_weight (17)
+// Unit: g
 func (s *MainRegion) ClearActualNettoFullWeight() *MainRegion {
 	s.internal.ActualNettoFullWeight = nil
 	return s
 }
 
 // SetNominalFullLength Sets the value of nominal_full_length (53)
+// Unit: mm
 func (s *MainRegion) SetNominalFullLength(value float64) *MainRegion {
 	s.internal.NominalFullLength = &value
 	return s
 }
 
 // GetNominalFullLength Gets the value of nominal_full_length (53)
+// Unit: mm
 func (s *MainRegion) GetNominalFullLength() (float64, bool) {
 	if s.internal.NominalFullLength != nil {
 		return *s.internal.NominalFullLength, true
@@ -507,18 +932,21 @@ func (s *MainRegion) GetNominalFullLength() (float64, bool) {
 }
 
 // ClearNominalFullLength Clears the value of nominal_full_length (53)
+// Unit: mm
 func (s *MainRegion) ClearNominalFullLength() *MainRegion {
 	s.internal.NominalFullLength = nil
 	return s
 }
 
 // SetActualFullLength Sets the value of actual_full_length (54)
+// Unit: mm
 func (s *MainRegion) SetActualFullLength(value float64) *MainRegion {
 	s.internal.ActualFullLength = &value
 	return s
 }
 
 // GetActualFullLength Gets the value of actual_full_length (54)
+// Unit: mm
 func (s *MainRegion) GetActualFullLength() (float64, bool) {
 	if s.internal.ActualFullLength != nil {
 		return *s.internal.ActualFullLength, true
@@ -527,6 +955,7 @@ func (s *MainRegion) GetActualFullLength() (float64, bool) {
 }
 
 // ClearActualFullLength Clears the value of actual_full_length (54)
+// Unit: mm
 func (s *MainRegion) ClearActualFullLength() *MainRegion {
 	s.internal.ActualFullLength = nil
 	return s
@@ -534,6 +963,7 @@ func (s *MainRegion) ClearActualFullLength() *MainRegion {
 
 // SetEmptyContainerWeight Sets the value of empty_container_weight (18)
 // Weight of the empty container.
+// Unit: g
 func (s *MainRegion) SetEmptyContainerWeight(value float64) *MainRegion {
 	s.internal.EmptyContainerWeight = &value
 	return s
@@ -541,6 +971,7 @@ func (s *MainRegion) SetEmptyContainerWeight(value float64) *MainRegion {
 
 // GetEmptyContainerWeight Gets the value of empty_container_weight (18)
 // Weight of the empty container.
+// Unit: g
 func (s *MainRegion) GetEmptyContainerWeight() (float64, bool) {
 	if s.internal.EmptyContainerWeight != nil {
 		return *s.internal.EmptyContainerWeight, true
@@ -550,6 +981,7 @@ func (s *MainRegion) GetEmptyContainerWeight() (float64, bool) {
 
 // ClearEmptyContainerWeight Clears the value of empty_container_weight (18)
 // Weight of the empty container.
+// Unit: g
 func (s *MainRegion) ClearEmptyContainerWeight() *MainRegion {
 	s.internal.EmptyContainerWeight = nil
 	return s
@@ -769,6 +1201,7 @@ func (s *MainRegion) ClearCertifications() *MainRegion {
 
 // SetDensity Sets the value of density (29)
 // Density of the material.
+// Unit: g/cm³
 func (s *MainRegion) SetDensity(value float64) *MainRegion {
 	s.internal.Density = &value
 	return s
@@ -776,6 +1209,7 @@ func (s *MainRegion) SetDensity(value float64) *MainRegion {
 
 // GetDensity Gets the value of density (29)
 // Density of the material.
+// Unit: g/cm³
 func (s *MainRegion) GetDensity() (float64, bool) {
 	if s.internal.Density != nil {
 		return *s.internal.Density, true
@@ -785,18 +1219,21 @@ func (s *MainRegion) GetDensity() (float64, bool) {
 
 // ClearDensity Clears the value of density (29)
 // Density of the material.
+// Unit: g/cm³
 func (s *MainRegion) ClearDensity() *MainRegion {
 	s.internal.Density = nil
 	return s
 }
 
 // SetFilamentDiameter Sets the value of filament_diameter (30)
+// Unit: mm
 func (s *MainRegion) SetFilamentDiameter(value float64) *MainRegion {
 	s.internal.FilamentDiameter = &value
 	return s
 }
 
 // GetFilamentDiameter Gets the value of filament_diameter (30)
+// Unit: mm
 func (s *MainRegion) GetFilamentDiameter() (float64, bool) {
 	if s.internal.FilamentDiameter != nil {
 		return *s.internal.FilamentDiameter, true
@@ -805,6 +1242,7 @@ func (s *MainRegion) GetFilamentDiameter() (float64, bool) {
 }
 
 // ClearFilamentDiameter Clears the value of filament_diameter (30)
+// Unit: mm
 func (s *MainRegion) ClearFilamentDiameter() *MainRegion {
 	s.internal.FilamentDiameter = nil
 	return s
@@ -851,12 +1289,14 @@ func (s *MainRegion) ClearShoreHardnessD() *MainRegion {
 }
 
 // SetMinNozzleDiameter Sets the value of min_nozzle_diameter (33)
+// Unit: mm
 func (s *MainRegion) SetMinNozzleDiameter(value float64) *MainRegion {
 	s.internal.MinNozzleDiameter = &value
 	return s
 }
 
 // GetMinNozzleDiameter Gets the value of min_nozzle_diameter (33)
+// Unit: mm
 func (s *MainRegion) GetMinNozzleDiameter() (float64, bool) {
 	if s.internal.MinNozzleDiameter != nil {
 		return *s.internal.MinNozzleDiameter, true
@@ -865,18 +1305,21 @@ func (s *MainRegion) GetMinNozzleDiameter() (float64, bool) {
 }
 
 // ClearMinNozzleDiameter Clears the value of min_nozzle_diameter (33)
+// Unit: mm
 func (s *MainRegion) ClearMinNozzleDiameter() *MainRegion {
 	s.internal.MinNozzleDiameter = nil
 	return s
 }
 
 // SetMinPrintTemperature Sets the value of min_print_temperature (34)
+// Unit: °C
 func (s *MainRegion) SetMinPrintTemperature(value int) *MainRegion {
 	s.internal.MinPrintTemperature = &value
 	return s
 }
 
 // GetMinPrintTemperature Gets the value of min_print_temperature (34)
+// Unit: °C
 func (s *MainRegion) GetMinPrintTemperature() (int, bool) {
 	if s.internal.MinPrintTemperature != nil {
 		return *s.internal.MinPrintTemperature, true
@@ -885,18 +1328,21 @@ func (s *MainRegion) GetMinPrintTemperature() (int, bool) {
 }
 
 // ClearMinPrintTemperature Clears the value of min_print_temperature (34)
+// Unit: °C
 func (s *MainRegion) ClearMinPrintTemperature() *MainRegion {
 	s.internal.MinPrintTemperature = nil
 	return s
 }
 
 // SetMaxPrintTemperature Sets the value of max_print_temperature (35)
+// Unit: °C
 func (s *MainRegion) SetMaxPrintTemperature(value int) *MainRegion {
 	s.internal.MaxPrintTemperature = &value
 	return s
 }
 
 // GetMaxPrintTemperature Gets the value of max_print_temperature (35)
+// Unit: °C
 func (s *MainRegion) GetMaxPrintTemperature() (int, bool) {
 	if s.internal.MaxPrintTemperature != nil {
 		return *s.internal.MaxPrintTemperature, true
@@ -905,18 +1351,21 @@ func (s *MainRegion) GetMaxPrintTemperature() (int, bool) {
 }
 
 // ClearMaxPrintTemperature Clears the value of max_print_temperature (35)
+// Unit: °C
 func (s *MainRegion) ClearMaxPrintTemperature() *MainRegion {
 	s.internal.MaxPrintTemperature = nil
 	return s
 }
 
 // SetPreheatTemperature Sets the value of preheat_temperature (36)
+// Unit: °C
 func (s *MainRegion) SetPreheatTemperature(value int) *MainRegion {
 	s.internal.PreheatTemperature = &value
 	return s
 }
 
 // GetPreheatTemperature Gets the value of preheat_temperature (36)
+// Unit: °C
 func (s *MainRegion) GetPreheatTemperature() (int, bool) {
 	if s.internal.PreheatTemperature != nil {
 		return *s.internal.PreheatTemperature, true
@@ -925,18 +1374,21 @@ func (s *MainRegion) GetPreheatTemperature() (int, bool) {
 }
 
 // ClearPreheatTemperature Clears the value of preheat_temperature (36)
+// Unit: °C
 func (s *MainRegion) ClearPreheatTemperature() *MainRegion {
 	s.internal.PreheatTemperature = nil
 	return s
 }
 
 // SetMinBedTemperature Sets the value of min_bed_temperature (37)
+// Unit: °C
 func (s *MainRegion) SetMinBedTemperature(value int) *MainRegion {
 	s.internal.MinBedTemperature = &value
 	return s
 }
 
 // GetMinBedTemperature Gets the value of min_bed_temperature (37)
+// Unit: °C
 func (s *MainRegion) GetMinBedTemperature() (int, bool) {
 	if s.internal.MinBedTemperature != nil {
 		return *s.internal.MinBedTemperature, true
@@ -945,18 +1397,21 @@ func (s *MainRegion) GetMinBedTemperature() (int, bool) {
 }
 
 // ClearMinBedTemperature Clears the value of min_bed_temperature (37)
+// Unit: °C
 func (s *MainRegion) ClearMinBedTemperature() *MainRegion {
 	s.internal.MinBedTemperature = nil
 	return s
 }
 
 // SetMaxBedTemperature Sets the value of max_bed_temperature (38)
+// Unit: °C
 func (s *MainRegion) SetMaxBedTemperature(value int) *MainRegion {
 	s.internal.MaxBedTemperature = &value
 	return s
 }
 
 // GetMaxBedTemperature Gets the value of max_bed_temperature (38)
+// Unit: °C
 func (s *MainRegion) GetMaxBedTemperature() (int, bool) {
 	if s.internal.MaxBedTemperature != nil {
 		return *s.internal.MaxBedTemperature, true
@@ -965,18 +1420,21 @@ func (s *MainRegion) GetMaxBedTemperature() (int, bool) {
 }
 
 // ClearMaxBedTemperature Clears the value of max_bed_temperature (38)
+// Unit: °C
 func (s *MainRegion) ClearMaxBedTemperature() *MainRegion {
 	s.internal.MaxBedTemperature = nil
 	return s
 }
 
 // SetMinChamberTemperature Sets the value of min_chamber_temperature (39)
+// Unit: °C
 func (s *MainRegion) SetMinChamberTemperature(value int) *MainRegion {
 	s.internal.MinChamberTemperature = &value
 	return s
 }
 
 // GetMinChamberTemperature Gets the value of min_chamber_temperature (39)
+// Unit: °C
 func (s *MainRegion) GetMinChamberTemperature() (int, bool) {
 	if s.internal.MinChamberTemperature != nil {
 		return *s.internal.MinChamberTemperature, true
@@ -985,18 +1443,21 @@ func (s *MainRegion) GetMinChamberTemperature() (int, bool) {
 }
 
 // ClearMinChamberTemperature Clears the value of min_chamber_temperature (39)
+// Unit: °C
 func (s *MainRegion) ClearMinChamberTemperature() *MainRegion {
 	s.internal.MinChamberTemperature = nil
 	return s
 }
 
 // SetMaxChamberTemperature Sets the value of max_chamber_temperature (40)
+// Unit: °C
 func (s *MainRegion) SetMaxChamberTemperature(value int) *MainRegion {
 	s.internal.MaxChamberTemperature = &value
 	return s
 }
 
 // GetMaxChamberTemperature Gets the value of max_chamber_temperature (40)
+// Unit: °C
 func (s *MainRegion) GetMaxChamberTemperature() (int, bool) {
 	if s.internal.MaxChamberTemperature != nil {
 		return *s.internal.MaxChamberTemperature, true
@@ -1005,18 +1466,21 @@ func (s *MainRegion) GetMaxChamberTemperature() (int, bool) {
 }
 
 // ClearMaxChamberTemperature Clears the value of max_chamber_temperature (40)
+// Unit: °C
 func (s *MainRegion) ClearMaxChamberTemperature() *MainRegion {
 	s.internal.MaxChamberTemperature = nil
 	return s
 }
 
 // SetChamberTemperature Sets the value of chamber_temperature (41)
+// Unit: °C
 func (s *MainRegion) SetChamberTemperature(value int) *MainRegion {
 	s.internal.ChamberTemperature = &value
 	return s
 }
 
 // GetChamberTemperature Gets the value of chamber_temperature (41)
+// Unit: °C
 func (s *MainRegion) GetChamberTemperature() (int, bool) {
 	if s.internal.ChamberTemperature != nil {
 		return *s.internal.ChamberTemperature, true
@@ -1025,18 +1489,21 @@ func (s *MainRegion) GetChamberTemperature() (int, bool) {
 }
 
 // ClearChamberTemperature Clears the value of chamber_temperature (41)
+// Unit: °C
 func (s *MainRegion) ClearChamberTemperature() *MainRegion {
 	s.internal.ChamberTemperature = nil
 	return s
 }
 
 // SetContainerWidth Sets the value of container_width (42)
+// Unit: mm
 func (s *MainRegion) SetContainerWidth(value int) *MainRegion {
 	s.internal.ContainerWidth = &value
 	return s
 }
 
 // GetContainerWidth Gets the value of container_width (42)
+// Unit: mm
 func (s *MainRegion) GetContainerWidth() (int, bool) {
 	if s.internal.ContainerWidth != nil {
 		return *s.internal.ContainerWidth, true
@@ -1045,18 +1512,21 @@ func (s *MainRegion) GetContainerWidth() (int, bool) {
 }
 
 // ClearContainerWidth Clears the value of container_width (42)
+// Unit: mm
 func (s *MainRegion) ClearContainerWidth() *MainRegion {
 	s.internal.ContainerWidth = nil
 	return s
 }
 
 // SetContainerOuterDiameter Sets the value of container_outer_diameter (43)
+// Unit: mm
 func (s *MainRegion) SetContainerOuterDiameter(value int) *MainRegion {
 	s.internal.ContainerOuterDiameter = &value
 	return s
 }
 
 // GetContainerOuterDiameter Gets the value of container_outer_diameter (43)
+// Unit: mm
 func (s *MainRegion) GetContainerOuterDiameter() (int, bool) {
 	if s.internal.ContainerOuterDiameter != nil {
 		return *s.internal.ContainerOuterDiameter, true
@@ -1065,18 +1535,21 @@ func (s *MainRegion) GetContainerOuterDiameter() (int, bool) {
 }
 
 // ClearContainerOuterDiameter Clears the value of container_outer_diameter (43)
+// Unit: mm
 func (s *MainRegion) ClearContainerOuterDiameter() *MainRegion {
 	s.internal.ContainerOuterDiameter = nil
 	return s
 }
 
 // SetContainerInnerDiameter Sets the value of container_inner_diameter (44)
+// Unit: mm
 func (s *MainRegion) SetContainerInnerDiameter(value int) *MainRegion {
 	s.internal.ContainerInnerDiameter = &value
 	return s
 }
 
 // GetContainerInnerDiameter Gets the value of container_inner_diameter (44)
+// Unit: mm
 func (s *MainRegion) GetContainerInnerDiameter() (int, bool) {
 	if s.internal.ContainerInnerDiameter != nil {
 		return *s.internal.ContainerInnerDiameter, true
@@ -1085,18 +1558,21 @@ func (s *MainRegion) GetContainerInnerDiameter() (int, bool) {
 }
 
 // ClearContainerInnerDiameter Clears the value of container_inner_diameter (44)
+// Unit: mm
 func (s *MainRegion) ClearContainerInnerDiameter() *MainRegion {
 	s.internal.ContainerInnerDiameter = nil
 	return s
 }
 
 // SetContainerHoleDiameter Sets the value of container_hole_diameter (45)
+// Unit: mm
 func (s *MainRegion) SetContainerHoleDiameter(value int) *MainRegion {
 	s.internal.ContainerHoleDiameter = &value
 	return s
 }
 
 // GetContainerHoleDiameter Gets the value of container_hole_diameter (45)
+// Unit: mm
 func (s *MainRegion) GetContainerHoleDiameter() (int, bool) {
 	if s.internal.ContainerHoleDiameter != nil {
 		return *s.internal.ContainerHoleDiameter, true
@@ -1105,6 +1581,7 @@ func (s *MainRegion) GetContainerHoleDiameter() (int, bool) {
 }
 
 // ClearContainerHoleDiameter Clears the value of container_hole_diameter (45)
+// Unit: mm
 func (s *MainRegion) ClearContainerHoleDiameter() *MainRegion {
 	s.internal.ContainerHoleDiameter = nil
 	return s
@@ -1112,6 +1589,7 @@ func (s *MainRegion) ClearContainerHoleDiameter() *MainRegion {
 
 // SetViscosity18C Sets the value of viscosity_18c (46)
 // Viscosity of the material at 18 °C.
+// Unit: mPa·s
 func (s *MainRegion) SetViscosity18C(value float64) *MainRegion {
 	s.internal.Viscosity18C = &value
 	return s
@@ -1119,6 +1597,7 @@ func (s *MainRegion) SetViscosity18C(value float64) *MainRegion {
 
 // GetViscosity18C Gets the value of viscosity_18c (46)
 // Viscosity of the material at 18 °C.
+// Unit: mPa·s
 func (s *MainRegion) GetViscosity18C() (float64, bool) {
 	if s.internal.Viscosity18C != nil {
 		return *s.internal.Viscosity18C, true
@@ -1128,6 +1607,7 @@ func (s *MainRegion) GetViscosity18C() (float64, bool) {
 
 // ClearViscosity18C Clears the value of viscosity_18c (46)
 // Viscosity of the material at 18 °C.
+// Unit: mPa·s
 func (s *MainRegion) ClearViscosity18C() *MainRegion {
 	s.internal.Viscosity18C = nil
 	return s
@@ -1135,6 +1615,7 @@ func (s *MainRegion) ClearViscosity18C() *MainRegion {
 
 // SetViscosity25C Sets the value of viscosity_25c (47)
 // Viscosity of the material at 25 °C.
+// Unit: mPa·s
 func (s *MainRegion) SetViscosity25C(value float64) *MainRegion {
 	s.internal.Viscosity25C = &value
 	return s
@@ -1142,6 +1623,7 @@ func (s *MainRegion) SetViscosity25C(value float64) *MainRegion {
 
 // GetViscosity25C Gets the value of viscosity_25c (47)
 // Viscosity of the material at 25 °C.
+// Unit: mPa·s
 func (s *MainRegion) GetViscosity25C() (float64, bool) {
 	if s.internal.Viscosity25C != nil {
 		return *s.internal.Viscosity25C, true
@@ -1151,6 +1633,7 @@ func (s *MainRegion) GetViscosity25C() (float64, bool) {
 
 // ClearViscosity25C Clears the value of viscosity_25c (47)
 // Viscosity of the material at 25 °C.
+// Unit: mPa·s
 func (s *MainRegion) ClearViscosity25C() *MainRegion {
 	s.internal.Viscosity25C = nil
 	return s
@@ -1158,6 +1641,7 @@ func (s *MainRegion) ClearViscosity25C() *MainRegion {
 
 // SetViscosity40C Sets the value of viscosity_40c (48)
 // Viscosity of the material at 40 °C.
+// Unit: mPa·s
 func (s *MainRegion) SetViscosity40C(value float64) *MainRegion {
 	s.internal.Viscosity40C = &value
 	return s
@@ -1165,6 +1649,7 @@ func (s *MainRegion) SetViscosity40C(value float64) *MainRegion {
 
 // GetViscosity40C Gets the value of viscosity_40c (48)
 // Viscosity of the material at 40 °C.
+// Unit: mPa·s
 func (s *MainRegion) GetViscosity40C() (float64, bool) {
 	if s.internal.Viscosity40C != nil {
 		return *s.internal.Viscosity40C, true
@@ -1174,6 +1659,7 @@ func (s *MainRegion) GetViscosity40C() (float64, bool) {
 
 // ClearViscosity40C Clears the value of viscosity_40c (48)
 // Viscosity of the material at 40 °C.
+// Unit: mPa·s
 func (s *MainRegion) ClearViscosity40C() *MainRegion {
 	s.internal.Viscosity40C = nil
 	return s
@@ -1181,6 +1667,7 @@ func (s *MainRegion) ClearViscosity40C() *MainRegion {
 
 // SetViscosity60C Sets the value of viscosity_60c (49)
 // Viscosity of the material at 60 °C.
+// Unit: mPa·s
 func (s *MainRegion) SetViscosity60C(value float64) *MainRegion {
 	s.internal.Viscosity60C = &value
 	return s
@@ -1188,6 +1675,7 @@ func (s *MainRegion) SetViscosity60C(value float64) *MainRegion {
 
 // GetViscosity60C Gets the value of viscosity_60c (49)
 // Viscosity of the material at 60 °C.
+// Unit: mPa·s
 func (s *MainRegion) GetViscosity60C() (float64, bool) {
 	if s.internal.Viscosity60C != nil {
 		return *s.internal.Viscosity60C, true
@@ -1197,6 +1685,7 @@ func (s *MainRegion) GetViscosity60C() (float64, bool) {
 
 // ClearViscosity60C Clears the value of viscosity_60c (49)
 // Viscosity of the material at 60 °C.
+// Unit: mPa·s
 func (s *MainRegion) ClearViscosity60C() *MainRegion {
 	s.internal.Viscosity60C = nil
 	return s
@@ -1204,6 +1693,7 @@ func (s *MainRegion) ClearViscosity60C() *MainRegion {
 
 // SetContainerVolumetricCapacity Sets the value of container_volumetric_capacity (50)
 // Maximum amount of material the container can hold.
+// Unit: ml
 func (s *MainRegion) SetContainerVolumetricCapacity(value float64) *MainRegion {
 	s.internal.ContainerVolumetricCapacity = &value
 	return s
@@ -1211,6 +1701,7 @@ func (s *MainRegion) SetContainerVolumetricCapacity(value float64) *MainRegion {
 
 // GetContainerVolumetricCapacity Gets the value of container_volumetric_capacity (50)
 // Maximum amount of material the container can hold.
+// Unit: ml
 func (s *MainRegion) GetContainerVolumetricCapacity() (float64, bool) {
 	if s.internal.ContainerVolumetricCapacity != nil {
 		return *s.internal.ContainerVolumetricCapacity, true
@@ -1220,18 +1711,21 @@ func (s *MainRegion) GetContainerVolumetricCapacity() (float64, bool) {
 
 // ClearContainerVolumetricCapacity Clears the value of container_volumetric_capacity (50)
 // Maximum amount of material the container can hold.
+// Unit: ml
 func (s *MainRegion) ClearContainerVolumetricCapacity() *MainRegion {
 	s.internal.ContainerVolumetricCapacity = nil
 	return s
 }
 
 // SetCureWavelength Sets the value of cure_wavelength (51)
+// Unit: nm
 func (s *MainRegion) SetCureWavelength(value int) *MainRegion {
 	s.internal.CureWavelength = &value
 	return s
 }
 
 // GetCureWavelength Gets the value of cure_wavelength (51)
+// Unit: nm
 func (s *MainRegion) GetCureWavelength() (int, bool) {
 	if s.internal.CureWavelength != nil {
 		return *s.internal.CureWavelength, true
@@ -1240,18 +1734,21 @@ func (s *MainRegion) GetCureWavelength() (int, bool) {
 }
 
 // ClearCureWavelength Clears the value of cure_wavelength (51)
+// Unit: nm
 func (s *MainRegion) ClearCureWavelength() *MainRegion {
 	s.internal.CureWavelength = nil
 	return s
 }
 
 // SetDryingTemperature Sets the value of drying_temperature (57)
+// Unit: °C
 func (s *MainRegion) SetDryingTemperature(value int) *MainRegion {
 	s.internal.DryingTemperature = &value
 	return s
 }
 
 // GetDryingTemperature Gets the value of drying_temperature (57)
+// Unit: °C
 func (s *MainRegion) GetDryingTemperature() (int, bool) {
 	if s.internal.DryingTemperature != nil {
 		return *s.internal.DryingTemperature, true
@@ -1260,18 +1757,21 @@ func (s *MainRegion) GetDryingTemperature() (int, bool) {
 }
 
 // ClearDryingTemperature Clears the value of drying_temperature (57)
+// Unit: °C
 func (s *MainRegion) ClearDryingTemperature() *MainRegion {
 	s.internal.DryingTemperature = nil
 	return s
 }
 
 // SetDryingTime Sets the value of drying_time (58)
+// Unit: min
 func (s *MainRegion) SetDryingTime(value int) *MainRegion {
 	s.internal.DryingTime = &value
 	return s
 }
 
 // GetDryingTime Gets the value of drying_time (58)
+// Unit: min
 func (s *MainRegion) GetDryingTime() (int, bool) {
 	if s.internal.DryingTime != nil {
 		return *s.internal.DryingTime, true
@@ -1280,6 +1780,7 @@ func (s *MainRegion) GetDryingTime() (int, bool) {
 }
 
 // ClearDryingTime Clears the value of drying_time (58)
+// Unit: min
 func (s *MainRegion) ClearDryingTime() *MainRegion {
 	s.internal.DryingTime = nil
 	return s
@@ -1294,6 +1795,24 @@ func (s *MainRegion) GetUnknownFields() map[any]any {
 	return s.internal.Unknowns
 }
 
+func (s mainInternal) MarshalJSON() ([]byte, error) {
+	// The plain type drops these methods so the standard encoder handles the known fields
+	type plain mainInternal
+	return marshalJSONWithUnknowns(plain(s), s.Unknowns)
+}
+
+func (s *mainInternal) UnmarshalJSON(data []byte) error {
+	type plain mainInternal
+	var known plain
+	unknowns, err := unmarshalJSONWithUnknowns(data, &known)
+	if err != nil {
+		return err
+	}
+	*s = mainInternal(known)
+	s.Unknowns = unknowns
+	return nil
+}
+
 func (s MainRegion) getInternal() any {
 	return &s.internal
 }
@@ -24,6 +24,7 @@ package openprinttag
 // ** THIS FILE IS AUTO-GENERATED, DO NOT MODIFY **
 
 import (
+	"encoding/json"
 	"fmt"
 	"gopkg.in/yaml.v3"
 )
@@ -48,6 +49,23 @@ var MaterialCertificationsMap = map[uint64]string{
 	2: "ul_2904",
 }
 
+// materialCertificationsInfo holds the specification information for all MaterialCertifications values
+var materialCertificationsInfo = []EnumInfo{
+	{
+		Key:  0,
+		Name: "ul_2818",
+	},
+	{
+		Key:  1,
+		Name: "ul_94_v0",
+	},
+	{
+		Key:         2,
+		Name:        "ul_2904",
+		Description: "Certifies that a 3D printing filament produces VOC and ultrafine particle emissions below safe thresholds when printed, making it safer for indoor use.",
+	},
+}
+
 func (e MaterialCertifications) String() string {
 	return MaterialCertificationsMap[uint64(e)]
 }
@@ -56,7 +74,8 @@ func (e MaterialCertifications) MarshalYAML() (any, error) {
 	if str, ok := MaterialCertificationsMap[uint64(e)]; ok {
 		return str, nil
 	}
-	return nil, fmt.Errorf("unknown enumeration: %d", e)
+	// Values unknown to this build are kept as their number
+	return uint64(e), nil
 }
 
 func (e *MaterialCertifications) UnmarshalYAML(value *yaml.Node) error {
@@ -64,13 +83,87 @@ func (e *MaterialCertifications) UnmarshalYAML(value *yaml.Node) error {
 	if err := value.Decode(&str); err != nil {
 		return err
 	}
+	key, err := enumKeyFromName(MaterialCertificationsMap, str)
+	if err != nil {
+		return err
+	}
+	*e = MaterialCertifications(key)
+	return nil
+}
+
+func (e MaterialCertifications) MarshalJSON() ([]byte, error) {
+	str, err := e.MarshalYAML()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(str)
+}
+
+func (e *MaterialCertifications) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		// Values unknown to this build are exported as numbers
+		var key uint64
+		if json.Unmarshal(data, &key) != nil {
+			return err
+		}
+		*e = MaterialCertifications(key)
+		return nil
+	}
+	key, err := enumKeyFromName(MaterialCertificationsMap, str)
+	if err != nil {
+		return err
+	}
+	*e = MaterialCertifications(key)
+	return nil
+}
+
+// Info returns the specification information for the value, false if the value is unknown
+func (e MaterialCertifications) Info() (EnumInfo, bool) {
+	return lookupEnumInfo(materialCertificationsInfo, uint64(e))
+}
+
+// DisplayName returns the specification name
+// Values unknown to this build are rendered as their number
+func (e MaterialCertifications) DisplayName() string {
+	info, found := e.Info()
+	if !found {
+		return fmt.Sprintf("%d", e)
+	}
+	return info.Name
+}
+
+// Description returns the specification description
+func (e MaterialCertifications) Description() string {
+	info, _ := e.Info()
+	return info.Description
+}
+
+// Abbreviation returns the abbreviation, if any
+func (e MaterialCertifications) Abbreviation() string {
+	info, _ := e.Info()
+	return info.Abbreviation
+}
+
+// IsDeprecated returns true if the specification has deprecated the value
+func (e MaterialCertifications) IsDeprecated() bool {
+	info, _ := e.Info()
+	return info.Deprecated
+}
+
+// ParseMaterialCertifications parses a value from its name, abbreviation or numeric key, ignoring case
+func ParseMaterialCertifications(str string) (MaterialCertifications, error) {
+	key, err := parseEnum(materialCertificationsInfo, str)
+	return MaterialCertifications(key), err
+}
 
-	// Hardly efficient, but this is not critical here
-	for key, name := range MaterialCertificationsMap {
-		if name == str {
-			*e = MaterialCertifications(key)
-			return nil
+// AllMaterialCertifications returns all values that are not deprecated, in specification order
+func AllMaterialCertifications() []MaterialCertifications {
+	values := make([]MaterialCertifications, 0, len(materialCertificationsInfo))
+	for _, info := range materialCertificationsInfo {
+		if !info.Deprecated {
+			values = append(values, MaterialCertifications(info.Key))
 		}
 	}
-	return fmt.Errorf("unknown enumeration: %s", str)
+	return values
 }
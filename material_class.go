@@ -0,0 +1,121 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	_ "embed"
+	"fmt"
+	"slices"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// materialClassRulesYAML lists the fields and material types applicable to each material class
+//
+//go:embed material_class_rules.yaml
+var materialClassRulesYAML []byte
+
+// materialClassRule is the decoded applicability rule for a single material class
+type materialClassRule struct {
+	Fields        []string `yaml:"fields"`
+	MaterialTypes []string `yaml:"material_types"`
+}
+
+// materialClassRules holds the decoded rules, keyed by material class name
+var materialClassRules = sync.OnceValue(func() map[string]materialClassRule {
+	var rules struct {
+		Classes map[string]materialClassRule `yaml:"classes"`
+	}
+	// The file is embedded and checked by the tests, so cannot fail here
+	err := yaml.Unmarshal(materialClassRulesYAML, &rules)
+	assertTrue(err == nil, "invalid material class rules: %v", err)
+	return rules.Classes
+})
+
+// FieldApplies returns false where the field (given by region and native name) is
+// specific to a material class other than class
+func FieldApplies(class MaterialClass, region, name string) bool {
+	return appliesTo(class, func(rule materialClassRule) []string { return rule.Fields }, region+"."+name)
+}
+
+// MaterialTypeApplies returns false where the material type is specific to a material
+// class other than class. No material types are currently listed as resin specific, so
+// this is only ever false for filament material types on a non FFF class
+func MaterialTypeApplies(class MaterialClass, materialType MaterialType) bool {
+	return appliesTo(class, func(rule materialClassRule) []string { return rule.MaterialTypes }, materialType.String())
+}
+
+// appliesTo returns true where the named item is listed for the class, or is not listed for any class
+func appliesTo(class MaterialClass, list func(materialClassRule) []string, name string) bool {
+	listed := false
+	for className, rule := range materialClassRules() {
+		if slices.Contains(list(rule), name) {
+			if className == class.String() {
+				return true
+			}
+			listed = true
+		}
+	}
+	return !listed
+}
+
+// materialTypeApplicabilityCheck flags a material type that does not apply to the material class
+func materialTypeApplicabilityCheck(o *OpenPrintTag) []plausibilityFinding {
+	class, classFound := o.knownMaterialClass()
+	materialType, typeFound := o.main.GetMaterialType()
+	if classFound && typeFound && !MaterialTypeApplies(class, materialType) {
+		info, _ := LookupField("main", "material_type")
+		return []plausibilityFinding{{field: &info, message: fmt.Sprintf("material_type %s is not applicable to material_class %s", materialType, class)}}
+	}
+	return nil
+}
+
+// fieldApplicabilityCheck flags fields that are set but do not apply to the material class
+func fieldApplicabilityCheck(o *OpenPrintTag) (findings []plausibilityFinding) {
+	class, found := o.knownMaterialClass()
+	if !found {
+		return nil
+	}
+	for _, fields := range [][]FieldInfo{mainFieldInfo, auxFieldInfo} {
+		for _, info := range fields {
+			if FieldApplies(class, info.Region, info.Name) {
+				continue
+			}
+			if _, set, _ := o.Get(info.Region + "." + info.Name); set {
+				info := info
+				findings = append(findings, plausibilityFinding{field: &info, message: fmt.Sprintf("%s.%s is not applicable to material_class %s", info.Region, info.Name, class)})
+			}
+		}
+	}
+	return
+}
+
+// knownMaterialClass returns the material class, where set to a value known to this build
+func (o *OpenPrintTag) knownMaterialClass() (MaterialClass, bool) {
+	class, found := o.main.GetMaterialClass()
+	if !found {
+		return class, false
+	}
+	_, known := class.Info()
+	return class, known
+}
@@ -24,6 +24,7 @@ package openprinttag
 // ** THIS FILE IS AUTO-GENERATED, DO NOT MODIFY **
 
 import (
+	"encoding/json"
 	"fmt"
 	"gopkg.in/yaml.v3"
 )
@@ -45,6 +46,20 @@ var MaterialClassMap = map[uint64]string{
 	1: "SLA",
 }
 
+// materialClassInfo holds the specification information for all MaterialClass values
+var materialClassInfo = []EnumInfo{
+	{
+		Key:         0,
+		Name:        "FFF",
+		Description: "Filament",
+	},
+	{
+		Key:         1,
+		Name:        "SLA",
+		Description: "Resin",
+	},
+}
+
 func (e MaterialClass) String() string {
 	return MaterialClassMap[uint64(e)]
 }
@@ -53,7 +68,8 @@ func (e MaterialClass) MarshalYAML() (any, error) {
 	if str, ok := MaterialClassMap[uint64(e)]; ok {
 		return str, nil
 	}
-	return nil, fmt.Errorf("unknown enumeration: %d", e)
+	// Values unknown to this build are kept as their number
+	return uint64(e), nil
 }
 
 func (e *MaterialClass) UnmarshalYAML(value *yaml.Node) error {
@@ -61,13 +77,87 @@ func (e *MaterialClass) UnmarshalYAML(value *yaml.Node) error {
 	if err := value.Decode(&str); err != nil {
 		return err
 	}
+	key, err := enumKeyFromName(MaterialClassMap, str)
+	if err != nil {
+		return err
+	}
+	*e = MaterialClass(key)
+	return nil
+}
+
+func (e MaterialClass) MarshalJSON() ([]byte, error) {
+	str, err := e.MarshalYAML()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(str)
+}
+
+func (e *MaterialClass) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		// Values unknown to this build are exported as numbers
+		var key uint64
+		if json.Unmarshal(data, &key) != nil {
+			return err
+		}
+		*e = MaterialClass(key)
+		return nil
+	}
+	key, err := enumKeyFromName(MaterialClassMap, str)
+	if err != nil {
+		return err
+	}
+	*e = MaterialClass(key)
+	return nil
+}
+
+// Info returns the specification information for the value, false if the value is unknown
+func (e MaterialClass) Info() (EnumInfo, bool) {
+	return lookupEnumInfo(materialClassInfo, uint64(e))
+}
+
+// DisplayName returns the specification name
+// Values unknown to this build are rendered as their number
+func (e MaterialClass) DisplayName() string {
+	info, found := e.Info()
+	if !found {
+		return fmt.Sprintf("%d", e)
+	}
+	return info.Name
+}
+
+// Description returns the specification description
+func (e MaterialClass) Description() string {
+	info, _ := e.Info()
+	return info.Description
+}
+
+// Abbreviation returns the abbreviation, if any
+func (e MaterialClass) Abbreviation() string {
+	info, _ := e.Info()
+	return info.Abbreviation
+}
+
+// IsDeprecated returns true if the specification has deprecated the value
+func (e MaterialClass) IsDeprecated() bool {
+	info, _ := e.Info()
+	return info.Deprecated
+}
+
+// ParseMaterialClass parses a value from its name, abbreviation or numeric key, ignoring case
+func ParseMaterialClass(str string) (MaterialClass, error) {
+	key, err := parseEnum(materialClassInfo, str)
+	return MaterialClass(key), err
+}
 
-	// Hardly efficient, but this is not critical here
-	for key, name := range MaterialClassMap {
-		if name == str {
-			*e = MaterialClass(key)
-			return nil
+// AllMaterialClass returns all values that are not deprecated, in specification order
+func AllMaterialClass() []MaterialClass {
+	values := make([]MaterialClass, 0, len(materialClassInfo))
+	for _, info := range materialClassInfo {
+		if !info.Deprecated {
+			values = append(values, MaterialClass(info.Key))
 		}
 	}
-	return fmt.Errorf("unknown enumeration: %s", str)
+	return values
 }
@@ -24,6 +24,7 @@ package openprinttag
 // ** THIS FILE IS AUTO-GENERATED, DO NOT MODIFY **
 
 import (
+	"encoding/json"
 	"fmt"
 	"gopkg.in/yaml.v3"
 )
@@ -287,6 +288,262 @@ var MaterialTypeMap = map[uint64]string{
 	41: "EVA",
 }
 
+// materialTypeInfo holds the specification information for all MaterialType values
+var materialTypeInfo = []EnumInfo{
+	{
+		Key:          0,
+		Name:         "Polylactic Acid",
+		Abbreviation: "PLA",
+		Description:  "Easy-to-print, biodegradable material. Ideal for beginners, prototypes, and models.",
+	},
+	{
+		Key:          1,
+		Name:         "Polyethylene Terephthalate Glycol",
+		Abbreviation: "PETG",
+		Description:  "Durable, strong, and temperature-resistant. Great for mechanical parts and functional prints.",
+	},
+	{
+		Key:          2,
+		Name:         "Thermoplastic Polyurethane",
+		Abbreviation: "TPU",
+		Description:  "A flexible, rubber-like material. Used for phone cases, vibration dampeners, and other soft parts.",
+	},
+	{
+		Key:          3,
+		Name:         "Acrylonitrile Butadiene Styrene",
+		Abbreviation: "ABS",
+		Description:  "Strong, durable, and heat-resistant plastic. Used for functional parts like car interiors and LEGOs. Requires a heated bed and enclosure.",
+	},
+	{
+		Key:          4,
+		Name:         "Acrylonitrile Styrene Acrylate",
+		Abbreviation: "ASA",
+		Description:  "Similar to ABS but with high UV and weather resistance, making it perfect for outdoor applications.",
+	},
+	{
+		Key:          5,
+		Name:         "Polycarbonate",
+		Abbreviation: "PC",
+		Description:  "Extremely strong, impact-resistant, and heat-resistant. Used for demanding engineering applications.",
+	},
+	{
+		Key:          6,
+		Name:         "Polycyclohexylenedimethylene Terephthalate Glycol",
+		Abbreviation: "PCTG",
+		Description:  "A tougher alternative to PETG with higher impact and chemical resistance.",
+	},
+	{
+		Key:          7,
+		Name:         "Polypropylene",
+		Abbreviation: "PP",
+		Description:  "Lightweight, chemically resistant, and flexible. Used for creating living hinges and durable containers.",
+	},
+	{
+		Key:          8,
+		Name:         "Polyamide 6",
+		Abbreviation: "PA6",
+		Description:  "A type of Nylon that is tough and wear-resistant but absorbs more moisture than other nylons.",
+	},
+	{
+		Key:          9,
+		Name:         "Polyamide 11",
+		Abbreviation: "PA11",
+		Description:  "A flexible, bio-based Nylon with low moisture absorption and good chemical resistance.",
+	},
+	{
+		Key:          10,
+		Name:         "Polyamide 12",
+		Abbreviation: "PA12",
+		Description:  "The most common Nylon for 3D printing. Strong, tough, with low moisture absorption. Great for functional parts.",
+	},
+	{
+		Key:          11,
+		Name:         "Polyamide 66",
+		Abbreviation: "PA66",
+		Description:  "A stiffer and more heat-resistant Nylon compared to PA6, used for durable mechanical parts.",
+	},
+	{
+		Key:          12,
+		Name:         "Copolyester",
+		Abbreviation: "CPE",
+		Description:  "A family of strong and dimensionally stable materials (including PETG) known for chemical resistance.",
+	},
+	{
+		Key:          13,
+		Name:         "Thermoplastic Elastomer",
+		Abbreviation: "TPE",
+		Description:  "A general class of soft, rubbery materials. Softer and more flexible than TPU.",
+	},
+	{
+		Key:          14,
+		Name:         "High Impact Polystyrene",
+		Abbreviation: "HIPS",
+		Description:  "A lightweight material often used as a dissolvable support material for ABS prints (dissolves in Limonene).",
+	},
+	{
+		Key:          15,
+		Name:         "Polyhydroxyalkanoate",
+		Abbreviation: "PHA",
+		Description:  "A biodegradable material similar to PLA but with better toughness and flexibility.",
+	},
+	{
+		Key:          16,
+		Name:         "Polyethylene Terephthalate",
+		Abbreviation: "PET",
+		Description:  "The same plastic used in water bottles. Strong and food-safe, but less common for printing than PETG.",
+	},
+	{
+		Key:          17,
+		Name:         "Polyetherimide",
+		Abbreviation: "PEI",
+		Description:  "A high-performance material (also known as Ultem) with excellent thermal and mechanical properties.",
+	},
+	{
+		Key:          18,
+		Name:         "Polybutylene Terephthalate",
+		Abbreviation: "PBT",
+		Description:  "An engineering polymer with good heat resistance and electrical insulation properties.",
+	},
+	{
+		Key:          19,
+		Name:         "Polyvinyl Butyral",
+		Abbreviation: "PVB",
+		Description:  "Easy to print and can be chemically smoothed with isopropyl alcohol for a glossy finish.",
+	},
+	{
+		Key:          20,
+		Name:         "Polyvinyl Alcohol",
+		Abbreviation: "PVA",
+		Description:  "A water-soluble filament used exclusively as a support material for complex prints.",
+	},
+	{
+		Key:          21,
+		Name:         "Polyetherketoneketone",
+		Abbreviation: "PEKK",
+		Description:  "An ultra-high-performance polymer with exceptional heat, chemical, and mechanical properties for industrial use.",
+	},
+	{
+		Key:          22,
+		Name:         "Polyether Ether Ketone",
+		Abbreviation: "PEEK",
+		Description:  "An ultra-high-performance polymer with exceptional mechanical, thermal, and chemical resistance. Used in demanding aerospace, medical, and industrial applications.",
+	},
+	{
+		Key:          23,
+		Name:         "Butenediol Vinyl Alcohol Copolymer",
+		Abbreviation: "BVOH",
+		Description:  "A water-soluble support material that often dissolves faster and is easier to print than PVA.",
+	},
+	{
+		Key:          24,
+		Name:         "Thermoplastic Copolyester",
+		Abbreviation: "TPC",
+		Description:  "A flexible, TPE-like material with good thermal and chemical resistance.",
+	},
+	{
+		Key:          25,
+		Name:         "Polyphenylene Sulfide",
+		Abbreviation: "PPS",
+		Description:  "A high-performance polymer known for its thermal stability and chemical resistance, often used in automotive and electronics.",
+	},
+	{
+		Key:          26,
+		Name:         "Polyphenylsulfone",
+		Abbreviation: "PPSU",
+		Description:  "A high-performance material with excellent heat and chemical resistance, often used in medical applications.",
+	},
+	{
+		Key:          27,
+		Name:         "Polyvinyl Chloride",
+		Abbreviation: "PVC",
+		Description:  "Strong and durable but rarely used in 3D printing due to the release of toxic fumes.",
+	},
+	{
+		Key:          28,
+		Name:         "Polyether Block Amide",
+		Abbreviation: "PEBA",
+		Description:  "A flexible and lightweight TPE known for its excellent energy return, used in sports equipment.",
+	},
+	{
+		Key:          29,
+		Name:         "Polyvinylidene Fluoride",
+		Abbreviation: "PVDF",
+		Description:  "High-performance polymer with excellent resistance to chemicals and UV light.",
+	},
+	{
+		Key:          30,
+		Name:         "Polyphthalamide",
+		Abbreviation: "PPA",
+		Description:  "A high-performance Nylon with superior strength, stiffness, and heat resistance compared to standard Nylons.",
+	},
+	{
+		Key:          31,
+		Name:         "Polycaprolactone",
+		Abbreviation: "PCL",
+		Description:  "A biodegradable polyester with a very low melting point (~60 °C), allowing it to be reshaped by hand in hot water.",
+	},
+	{
+		Key:          32,
+		Name:         "Polyethersulfone",
+		Abbreviation: "PES",
+		Description:  "A high-temperature, amorphous polymer with good chemical and hydrolytic stability.",
+	},
+	{
+		Key:          33,
+		Name:         "Polymethyl Methacrylate",
+		Abbreviation: "PMMA",
+		Description:  "A rigid, transparent material also known as acrylic. Offers good optical clarity.",
+	},
+	{
+		Key:          34,
+		Name:         "Polyoxymethylene",
+		Abbreviation: "POM",
+		Description:  "A low-friction, rigid material also known as Delrin. Excellent for gears, bearings, and moving parts.",
+	},
+	{
+		Key:          35,
+		Name:         "Polyphenylene Ether",
+		Abbreviation: "PPE",
+		Description:  "An engineering thermoplastic with good temperature resistance and dimensional stability, often used in blends.",
+	},
+	{
+		Key:          36,
+		Name:         "Polystyrene",
+		Abbreviation: "PS",
+		Description:  "A lightweight and brittle material. Not commonly used in its pure form for 3D printing.",
+	},
+	{
+		Key:          37,
+		Name:         "Polysulfone",
+		Abbreviation: "PSU",
+		Description:  "A high-temperature material with good thermal stability and chemical resistance.",
+	},
+	{
+		Key:          38,
+		Name:         "Thermoplastic Polyimide",
+		Abbreviation: "TPI",
+		Description:  "An ultra-high-performance polymer with one of the highest glass transition temperatures and excellent thermal stability.",
+	},
+	{
+		Key:          39,
+		Name:         "Styrene-Butadiene-Styrene",
+		Abbreviation: "SBS",
+		Description:  "A flexible, rubber-like material (a type of TPE) known for good durability. It is relatively easy to print for a flexible filament.",
+	},
+	{
+		Key:          40,
+		Name:         "Olefin Block Copolymer",
+		Abbreviation: "OBC",
+		Description:  "A lightweight flexible material that has good dimensional stability and is weather, UV, and chemical resistant.",
+	},
+	{
+		Key:          41,
+		Name:         "Ethylene Vinyl Acetate",
+		Abbreviation: "EVA",
+		Description:  "A flexible, soft material with rubber-like properties, known for its toughness and resistance to UV radiation and stress cracking.",
+	},
+}
+
 func (e MaterialType) String() string {
 	return MaterialTypeMap[uint64(e)]
 }
@@ -295,7 +552,8 @@ func (e MaterialType) MarshalYAML() (any, error) {
 	if str, ok := MaterialTypeMap[uint64(e)]; ok {
 		return str, nil
 	}
-	return nil, fmt.Errorf("unknown enumeration: %d", e)
+	// Values unknown to this build are kept as their number
+	return uint64(e), nil
 }
 
 func (e *MaterialType) UnmarshalYAML(value *yaml.Node) error {
@@ -303,13 +561,87 @@ func (e *MaterialType) UnmarshalYAML(value *yaml.Node) error {
 	if err := value.Decode(&str); err != nil {
 		return err
 	}
+	key, err := enumKeyFromName(MaterialTypeMap, str)
+	if err != nil {
+		return err
+	}
+	*e = MaterialType(key)
+	return nil
+}
+
+func (e MaterialType) MarshalJSON() ([]byte, error) {
+	str, err := e.MarshalYAML()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(str)
+}
+
+func (e *MaterialType) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		// Values unknown to this build are exported as numbers
+		var key uint64
+		if json.Unmarshal(data, &key) != nil {
+			return err
+		}
+		*e = MaterialType(key)
+		return nil
+	}
+	key, err := enumKeyFromName(MaterialTypeMap, str)
+	if err != nil {
+		return err
+	}
+	*e = MaterialType(key)
+	return nil
+}
+
+// Info returns the specification information for the value, false if the value is unknown
+func (e MaterialType) Info() (EnumInfo, bool) {
+	return lookupEnumInfo(materialTypeInfo, uint64(e))
+}
+
+// DisplayName returns the specification name
+// Values unknown to this build are rendered as their number
+func (e MaterialType) DisplayName() string {
+	info, found := e.Info()
+	if !found {
+		return fmt.Sprintf("%d", e)
+	}
+	return info.Name
+}
+
+// Description returns the specification description
+func (e MaterialType) Description() string {
+	info, _ := e.Info()
+	return info.Description
+}
+
+// Abbreviation returns the abbreviation, if any
+func (e MaterialType) Abbreviation() string {
+	info, _ := e.Info()
+	return info.Abbreviation
+}
+
+// IsDeprecated returns true if the specification has deprecated the value
+func (e MaterialType) IsDeprecated() bool {
+	info, _ := e.Info()
+	return info.Deprecated
+}
+
+// ParseMaterialType parses a value from its name, abbreviation or numeric key, ignoring case
+func ParseMaterialType(str string) (MaterialType, error) {
+	key, err := parseEnum(materialTypeInfo, str)
+	return MaterialType(key), err
+}
 
-	// Hardly efficient, but this is not critical here
-	for key, name := range MaterialTypeMap {
-		if name == str {
-			*e = MaterialType(key)
-			return nil
+// AllMaterialType returns all values that are not deprecated, in specification order
+func AllMaterialType() []MaterialType {
+	values := make([]MaterialType, 0, len(materialTypeInfo))
+	for _, info := range materialTypeInfo {
+		if !info.Deprecated {
+			values = append(values, MaterialType(info.Key))
 		}
 	}
-	return fmt.Errorf("unknown enumeration: %s", str)
+	return values
 }
@@ -32,7 +32,7 @@ func (o *OpenPrintTag) Merge(other *OpenPrintTag, overwrite bool) {
 	mergeRegion(reflect.ValueOf(&o.main.internal), reflect.ValueOf(&other.main.internal), overwrite)
 	if other.aux != nil {
 		if o.aux == nil {
-			o.aux = &AuxRegion{internal: auxInternal{}}
+			o.aux = newAuxRegion()
 			if _, found := o.meta.GetAuxRegionOffset(); !found {
 				incomingMetaOffset, _ := other.meta.GetAuxRegionOffset()
 				o.meta.SetAuxRegionOffset(incomingMetaOffset)
@@ -24,11 +24,11 @@ package openprinttag
 // ** THIS FILE IS AUTO-GENERATED, DO NOT MODIFY **
 
 type metaInternal struct {
-	MainRegionOffset *int        `cbor:"0,keyasint,omitempty" yaml:"main_region_offset,omitempty" opt:"name=main_region_offset,key=0"`
-	MainRegionSize   *int        `cbor:"1,keyasint,omitempty" yaml:"main_region_size,omitempty" opt:"name=main_region_size,key=1"`
-	AuxRegionOffset  *int        `cbor:"2,keyasint,omitempty" yaml:"aux_region_offset,omitempty" opt:"name=aux_region_offset,key=2"`
-	AuxRegionSize    *int        `cbor:"3,keyasint,omitempty" yaml:"aux_region_size,omitempty" opt:"name=aux_region_size,key=3"`
-	Unknowns         map[any]any `cbor:"-" yaml:"other,omitempty"`
+	MainRegionOffset *int        `cbor:"0,keyasint,omitempty" yaml:"main_region_offset,omitempty" json:"main_region_offset,omitempty" opt:"name=main_region_offset,key=0"`
+	MainRegionSize   *int        `cbor:"1,keyasint,omitempty" yaml:"main_region_size,omitempty" json:"main_region_size,omitempty" opt:"name=main_region_size,key=1"`
+	AuxRegionOffset  *int        `cbor:"2,keyasint,omitempty" yaml:"aux_region_offset,omitempty" json:"aux_region_offset,omitempty" opt:"name=aux_region_offset,key=2"`
+	AuxRegionSize    *int        `cbor:"3,keyasint,omitempty" yaml:"aux_region_size,omitempty" json:"aux_region_size,omitempty" opt:"name=aux_region_size,key=3"`
+	Unknowns         map[any]any `cbor:"-" yaml:"other,omitempty" json:"-"`
 }
 
 type MetaRegion struct {
@@ -36,6 +36,34 @@ type MetaRegion struct {
 	regionOptions *RegionOptions
 }
 
+// metaFieldInfo lists all meta region fields defined by the specification
+var metaFieldInfo = []FieldInfo{
+	{
+		Region: "meta",
+		Key:    0,
+		Name:   "main_region_offset",
+		Type:   FieldTypeInt,
+	},
+	{
+		Region: "meta",
+		Key:    1,
+		Name:   "main_region_size",
+		Type:   FieldTypeInt,
+	},
+	{
+		Region: "meta",
+		Key:    2,
+		Name:   "aux_region_offset",
+		Type:   FieldTypeInt,
+	},
+	{
+		Region: "meta",
+		Key:    3,
+		Name:   "aux_region_size",
+		Type:   FieldTypeInt,
+	},
+}
+
 // SetMainRegionOffset Sets the value of main_region_offset (0)
 func (s *MetaRegion) SetMainRegionOffset(value int) *MetaRegion {
 	s.internal.MainRegionOffset = &value
@@ -125,6 +153,24 @@ func (s *MetaRegion) GetUnknownFields() map[any]any {
 	return s.internal.Unknowns
 }
 
+func (s metaInternal) MarshalJSON() ([]byte, error) {
+	// The plain type drops these methods so the standard encoder handles the known fields
+	type plain metaInternal
+	return marshalJSONWithUnknowns(plain(s), s.Unknowns)
+}
+
+func (s *metaInternal) UnmarshalJSON(data []byte) error {
+	type plain metaInternal
+	var known plain
+	unknowns, err := unmarshalJSONWithUnknowns(data, &known)
+	if err != nil {
+		return err
+	}
+	*s = metaInternal(known)
+	s.Unknowns = unknowns
+	return nil
+}
+
 func (s MetaRegion) getInternal() any {
 	return &s.internal
 }
@@ -23,8 +23,11 @@ package openprinttag
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"maps"
 	"regexp"
+	"slices"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -72,6 +75,36 @@ const (
 type RegionOptions struct {
 	cborContainerType CBORContainerType
 	floatMaxPrecision FloatMaxPrecision
+
+	// keyOrder is the order in which keys appeared in a decoded region
+	keyOrder []any
+
+	// floatEncodings records the width used for each floating point field in a decoded region
+	floatEncodings map[uint64]floatEncoding
+
+	// indefiniteArrays records, for each array in a decoded region, whether it was
+	// encoded in indefinite form
+	indefiniteArrays map[any]bool
+}
+
+// clone returns an independent copy of the region options
+func (e *RegionOptions) clone() *RegionOptions {
+	c := *e
+	c.keyOrder = slices.Clone(e.keyOrder)
+	c.floatEncodings = maps.Clone(e.floatEncodings)
+	c.indefiniteArrays = maps.Clone(e.indefiniteArrays)
+	return &c
+}
+
+// ClearDecodedLayout discards the key order, floating point widths and array forms that
+// were recorded when the region was decoded, so that the region will be encoded
+// in canonical form (fields in key order, smallest float representation, arrays
+// following the container type)
+func (e *RegionOptions) ClearDecodedLayout() *RegionOptions {
+	e.keyOrder = nil
+	e.floatEncodings = nil
+	e.indefiniteArrays = nil
+	return e
 }
 
 // GetCBORContainerType returns the encoding type for CBOR containers
@@ -117,6 +150,11 @@ const (
 	// without the NFC-V capability container, which is useful when writing/reading
 	// tags to non-compliant earlier NFC versions
 	WithoutCapabilityContainer EncodeDecodeOption = iota
+
+	// Type2Layout instructs the encoder/decoder to use the NFC Forum Type 2 (NTAG21x)
+	// layout in place of NFC-V. The image starts with the capability container (page 3)
+	// followed by the data area (from page 4) which begins with a Lock Control TLV
+	Type2Layout
 )
 
 // Used to validate color declarations
@@ -145,6 +183,26 @@ func (c *ColorRGBA) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// MarshalJSON converts ColorRGBA to #RRGGBB(AA) string representation
+func (c ColorRGBA) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON converts JSON form of ColorRBGA back to object
+func (c *ColorRGBA) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	colorBytes, err := NewColor(str)
+	if err != nil {
+		return err
+	}
+	*c = colorBytes
+	return nil
+}
+
 func (c ColorRGBA) String() string {
 	return "#" + hex.EncodeToString(c)
 }
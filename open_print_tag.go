@@ -23,11 +23,16 @@ package openprinttag
 
 import (
 	"fmt"
+	"slices"
+	"time"
 )
 
 const (
 	mimeType          = "application/vnd.openprinttag"
 	maxMetaRegionSize = 8
+
+	// extendedCCSize is the size of the NFC-V capability container used for tags beyond 2040 bytes
+	extendedCCSize = 8
 )
 
 // RecoverAssertions may be set to false for debugging
@@ -62,10 +67,45 @@ type OpenPrintTag struct {
 	aux            *AuxRegion
 	size           int
 	blockSize      int
-	uri            string
 	metaRegionSize int
 	auxRegionSize  int
 	stats          *Stats
+
+	// stirInterval is used by StirringDue, DefaultStirInterval where zero
+	stirInterval time.Duration
+
+	// tagUID is the NFC UID of the physical tag, where known, from which the instance UUID is derived
+	tagUID []byte
+
+	// records are the NDEF records other than the open print tag record, in tag order
+	// the first recordsBefore of them preceed the open print tag record
+	records       []NDEFRecord
+	recordsBefore int
+
+	// layout retains the binary layout of a decoded tag, allowing
+	// an unmodified tag to be re-encoded identically
+	layout *tagLayout
+}
+
+// tagLayout describes those parts of a decoded tag's binary image that
+// are not otherwise represented in the OpenPrintTag
+type tagLayout struct {
+	// size is the size of the decoded image, layout is only reused
+	// where the tag size is unchanged
+	size int
+
+	// capabilityContainer is the original capability container (nil if decoded without)
+	capabilityContainer []byte
+
+	// preambleTLVs holds any TLVs that preceeded the NDEF TLV (lock control, NULL etc.)
+	preambleTLVs []byte
+
+	// payload is the original open print tag record payload, which provides the
+	// content of any bytes not occupied by the regions themselves
+	payload []byte
+
+	// trailer holds the bytes following the NDEF TLV and terminator
+	trailer []byte
 }
 
 // NewOpenPrintTag creates a new, blank, open print tag
@@ -108,9 +148,17 @@ func (o *OpenPrintTag) WithBlockSize(blockSize int) *OpenPrintTag {
 	return o
 }
 
-// WithURIRecord adds an optional URI record to the tag
+// WithURIRecord adds an optional URI record to the tag, replacing any existing URI record
+// An empty URI removes the URI record
 func (o *OpenPrintTag) WithURIRecord(uri string) *OpenPrintTag {
-	o.uri = uri
+	o.setURIRecord(uri)
+	return o
+}
+
+// WithTagUID sets the NFC UID of the physical tag, from which the instance UUID is derived
+// where the tag does not hold one (see MaterialPackageInstanceUUID)
+func (o *OpenPrintTag) WithTagUID(uid []byte) *OpenPrintTag {
+	o.tagUID = slices.Clone(uid)
 	return o
 }
 
@@ -133,6 +181,23 @@ func (o *OpenPrintTag) AuxRegion() *AuxRegion {
 	return o.aux
 }
 
+// ClearDecodedLayout discards all details of the original binary layout that
+// were recorded when the tag was decoded, for the tag and all of its regions.
+// Subsequent encodes will produce a freshly laid out tag
+func (o *OpenPrintTag) ClearDecodedLayout() *OpenPrintTag {
+	o.layout = nil
+	if o.meta != nil {
+		o.meta.RegionOptions().ClearDecodedLayout()
+	}
+	if o.main != nil {
+		o.main.RegionOptions().ClearDecodedLayout()
+	}
+	if o.aux != nil {
+		o.aux.RegionOptions().ClearDecodedLayout()
+	}
+	return o
+}
+
 // RemoveAuxRegion will remove the aux region from the tag
 func (o *OpenPrintTag) RemoveAuxRegion() *OpenPrintTag {
 	o.aux = nil
@@ -152,15 +217,14 @@ func (o *OpenPrintTag) String() string {
 
 // IsValid returns true if the tag has no errors
 func (o *OpenPrintTag) IsValid() bool {
-	e, _ := o.Validate()
-	return len(e) == 0
+	return !o.Validate().HasErrors()
 }
 
 // newMetaRegion creates a new empty meta region, with appropriate default encoding options
 func newMetaRegion() *MetaRegion {
 	return &MetaRegion{
 		internal:      metaInternal{},
-		regionOptions: defaultMetaOptions,
+		regionOptions: defaultMetaOptions.clone(),
 	}
 }
 
@@ -168,7 +232,7 @@ func newMetaRegion() *MetaRegion {
 func newMainRegion() *MainRegion {
 	return &MainRegion{
 		internal:      mainInternal{},
-		regionOptions: defaultMainOptions,
+		regionOptions: defaultMainOptions.clone(),
 	}
 }
 
@@ -176,6 +240,6 @@ func newMainRegion() *MainRegion {
 func newAuxRegion() *AuxRegion {
 	return &AuxRegion{
 		internal:      auxInternal{},
-		regionOptions: defaultAuxOptions,
+		regionOptions: defaultAuxOptions.clone(),
 	}
 }
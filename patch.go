@@ -0,0 +1,143 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrPatchOutsideAux is returned by EncodeAuxPatch when the re-encoded tag
+// differs from the original outside of the aux region
+var ErrPatchOutsideAux = errors.New("tag changes are not confined to the aux region")
+
+// ErrPatchImageTooSmall is returned by EncodeAuxPatch when the original tag image
+// is smaller than the re-encoded tag
+var ErrPatchImageTooSmall = errors.New("original tag image is smaller than the encoded tag")
+
+// BlockPatch is a contiguous, block aligned, range of bytes that must be
+// written to a tag to bring it up to date
+type BlockPatch struct {
+	// Offset is the byte offset within the tag image at which Data must be written
+	// and is always a multiple of the block size
+	Offset int
+
+	// Data is the replacement content, always a multiple of the block size in length
+	// (except where the final block is truncated by the end of the tag image)
+	Data []byte
+}
+
+// EncodeAuxPatch re-encodes the tag and compares it against the original tag image
+// (normally the bytes that the tag was decoded from), returning the minimal set of
+// block aligned ranges that need to be written to bring the physical tag up to date.
+// Block alignment honors WithBlockSize.
+// If any byte outside of the aux region would change, ErrPatchOutsideAux is returned
+// and no patches are produced, so that a locked main region is never touched.
+// Changed blocks are written whole, so the aux region must start on a block boundary
+// for the patches to leave a locked main region alone. The encoder aligns the aux region
+// when it places it, but a tag decoded with an unaligned aux region offset keeps that
+// offset, and the first patch may then include unchanged bytes of the main region.
+// An empty result indicates the tag is already up to date
+func (o *OpenPrintTag) EncodeAuxPatch(original []byte, opts ...EncodeDecodeOption) (patches []BlockPatch, err error) {
+	if RecoverAssertions {
+		defer func() {
+			if r := recover(); r != nil {
+				switch x := r.(type) {
+				case string:
+					err = errors.New(x)
+				case error:
+					err = x
+				default:
+					err = errors.New("unknown panic occurred")
+				}
+			}
+		}()
+	}
+	return o.encodeAuxPatch(original, opts...)
+}
+
+// encodeAuxPatch implements EncodeAuxPatch, with assertion panics
+func (o *OpenPrintTag) encodeAuxPatch(original []byte, opts ...EncodeDecodeOption) ([]BlockPatch, error) {
+	if o.aux == nil {
+		return nil, errors.New("tag has no aux region to patch")
+	}
+
+	updated, err := o.encode(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// The encoder can produce an image one byte shorter than the tag size, that last byte
+	// lies beyond the TLV terminator and is never rewritten
+	if len(original) < len(updated) {
+		return nil, fmt.Errorf("%w: %d bytes against %d", ErrPatchImageTooSmall, len(original), len(updated))
+	}
+
+	// Stats offsets are measured with the CC present
+	ccOffset := 0
+	if slices.Contains(opts, WithoutCapabilityContainer) {
+		ccOffset = o.stats.Root.CapabilityContainerSize
+	}
+	auxStart := o.stats.Aux.AbsoluteOffset - ccOffset
+	auxEnd := auxStart + o.stats.Aux.Size
+
+	var patches []BlockPatch
+	for blockStart := 0; blockStart < len(updated); blockStart += o.blockSize {
+		blockEnd := min(blockStart+o.blockSize, len(updated))
+
+		changed := false
+		for idx := blockStart; idx < blockEnd; idx++ {
+			if original[idx] == updated[idx] {
+				continue
+			}
+			if idx < auxStart || idx >= auxEnd {
+				return nil, fmt.Errorf("%w: byte at offset %d differs", ErrPatchOutsideAux, idx)
+			}
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+
+		// Extend the previous patch if this block is contiguous with it
+		if last := len(patches) - 1; last >= 0 && patches[last].Offset+len(patches[last].Data) == blockStart {
+			patches[last].Data = append(patches[last].Data, updated[blockStart:blockEnd]...)
+		} else {
+			patches = append(patches, BlockPatch{Offset: blockStart, Data: slices.Clone(updated[blockStart:blockEnd])})
+		}
+	}
+
+	return patches, nil
+}
+
+// ApplyPatches writes the patches into a copy of image, returning the patched copy
+func ApplyPatches(image []byte, patches []BlockPatch) ([]byte, error) {
+	patched := slices.Clone(image)
+	for _, patch := range patches {
+		if patch.Offset < 0 || patch.Offset+len(patch.Data) > len(patched) {
+			return nil, fmt.Errorf("patch at offset %d with length %d exceeds image size of %d", patch.Offset, len(patch.Data), len(patched))
+		}
+		copy(patched[patch.Offset:], patch.Data)
+	}
+	return patched, nil
+}
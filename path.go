@@ -0,0 +1,415 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	st "github.com/cjbearman/openprinttag/structtags"
+)
+
+// ErrUnknownField is returned by Get, Set and Unset when the path does not name a field
+var ErrUnknownField = errors.New("unknown field")
+
+// Get returns the value of the field at path, given as region.field_name (for example
+// main.min_print_temperature) or region.key for unknown/vendor fields (for example aux.65530).
+// Values are returned in the same types as the region getters, so timestamps are time.Time
+// and enumerations are their enumeration type.
+// found is false where the field is not set
+func (o *OpenPrintTag) Get(path string) (value any, found bool, err error) {
+	target, err := o.resolvePath(path, false)
+	if err != nil || target.region == nil {
+		return nil, false, err
+	}
+
+	if target.info == nil {
+		value, found = target.region.GetUnknownFields()[target.key]
+		return value, found, nil
+	}
+
+	field := target.field()
+	if field.IsNil() {
+		return nil, false, nil
+	}
+	if target.info.Type == FieldTypeTimestamp {
+		return time.Unix(int64(field.Elem().Uint()), 0), true, nil
+	}
+	return field.Elem().Interface(), true, nil
+}
+
+// Set sets the field at path (see Get) to value, which may be the native type of the field
+// or a representation that can be coerced to it:
+// integers and numbers from any numeric type or string,
+// fields with a unit from a quantity string such as "1 kg" (see ParseQuantity),
+// timestamps from time.Time, Unix time or an RFC3339 string,
+// enumerations from their name or numeric value,
+// enumeration arrays from a slice or a comma separated string of names,
+// colors from #rrggbb(aa) strings and UUIDs from strings.
+// Unknown/vendor fields are stored exactly as given
+func (o *OpenPrintTag) Set(path string, value any) error {
+	target, err := o.resolvePath(path, true)
+	if err != nil {
+		return err
+	}
+
+	if target.info == nil {
+		target.region.GetUnknownFields()[target.key] = value
+		return nil
+	}
+
+	field := target.field()
+	coerced, err := coerceFieldValue(*target.info, field.Type().Elem(), value)
+	if err != nil {
+		return fmt.Errorf("field %s expects %s: %w", path, expectedFieldType(*target.info), err)
+	}
+	ptr := reflect.New(field.Type().Elem())
+	ptr.Elem().Set(coerced)
+	field.Set(ptr)
+	return nil
+}
+
+// Unset clears the field at path (see Get)
+func (o *OpenPrintTag) Unset(path string) error {
+	target, err := o.resolvePath(path, false)
+	if err != nil || target.region == nil {
+		return err
+	}
+
+	if target.info == nil {
+		delete(target.region.GetUnknownFields(), target.key)
+		return nil
+	}
+	field := target.field()
+	field.Set(reflect.Zero(field.Type()))
+	return nil
+}
+
+// pathTarget is a field resolved from a path, info is nil for unknown fields
+type pathTarget struct {
+	region   Region
+	internal reflect.Value
+	info     *FieldInfo
+	key      uint64
+}
+
+// field returns the (pointer) struct field holding a known field's value
+func (t pathTarget) field() reflect.Value {
+	internal := t.internal
+	for i := 0; i < internal.NumField(); i++ {
+		tag := internal.Type().Field(i).Tag.Get(st.OptTag)
+		if tag != "" && decodeOptTag(tag)[st.OptTagName] == t.info.Name {
+			return internal.Field(i)
+		}
+	}
+
+	// The registry and the region structs are generated together, so this cannot happen
+	panic(fmt.Sprintf("field %s.%s has no struct field", t.info.Region, t.info.Name))
+}
+
+// resolvePath finds the region and field named by path, the region is nil where
+// it does not exist and create is false (an absent aux region)
+func (o *OpenPrintTag) resolvePath(path string, create bool) (pathTarget, error) {
+	regionName, fieldName, found := strings.Cut(path, ".")
+	if !found {
+		return pathTarget{}, fmt.Errorf("%w: %s, must be in the form region.field_name", ErrUnknownField, path)
+	}
+
+	var target pathTarget
+	switch regionName {
+	case "meta":
+		target.region, target.internal = o.meta, reflect.ValueOf(&o.meta.internal).Elem()
+	case "main":
+		target.region, target.internal = o.main, reflect.ValueOf(&o.main.internal).Elem()
+	case "aux":
+		if o.aux != nil || create {
+			target.region, target.internal = o.AuxRegion(), reflect.ValueOf(&o.AuxRegion().internal).Elem()
+		}
+	default:
+		return pathTarget{}, fmt.Errorf("%w: %s, region must be meta, main or aux", ErrUnknownField, path)
+	}
+
+	if info, found := LookupField(regionName, fieldName); found {
+		target.info = &info
+		return target, nil
+	}
+
+	// Anything else must be the numeric key of an unknown field
+	key, err := strconv.ParseUint(fieldName, 10, 64)
+	if err != nil {
+		return pathTarget{}, fmt.Errorf("%w: %s", ErrUnknownField, path)
+	}
+	target.key = key
+	return target, nil
+}
+
+// expectedFieldType describes the values accepted for a field, for error messages
+func expectedFieldType(info FieldInfo) string {
+	switch info.Type {
+	case FieldTypeUint64:
+		return "a non-negative integer"
+	case FieldTypeInt:
+		return "an integer"
+	case FieldTypeNumber:
+		return "a number"
+	case FieldTypeString:
+		return "a string"
+	case FieldTypeUUID:
+		return "a UUID"
+	case FieldTypeColorRGBA:
+		return "a color (#rrggbb or #rrggbbaa)"
+	case FieldTypeTimestamp:
+		return "a timestamp (RFC3339 or Unix time)"
+	case FieldTypeEnum:
+		return "a " + info.Enum + " value"
+	case FieldTypeEnumArray:
+		return "a list of " + info.Enum + " values"
+	default:
+		return string(info.Type)
+	}
+}
+
+// coerceFieldValue converts value to the given field type
+func coerceFieldValue(info FieldInfo, fieldType reflect.Type, value any) (reflect.Value, error) {
+	if value == nil {
+		return reflect.Value{}, errors.New("got nil")
+	}
+
+	// Fields with a unit also accept a quantity string, such as "1 kg" or "410 F",
+	// which is converted to the unit of the field
+	if str, ok := value.(string); ok && info.Unit != "" && quantityPattern.MatchString(strings.TrimSpace(str)) {
+		if _, err := strconv.ParseFloat(strings.TrimSpace(str), 64); err != nil {
+			quantity, err := ParseQuantity(str)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			if value, err = quantityFieldValue(info, quantity); err != nil {
+				return reflect.Value{}, err
+			}
+		}
+	}
+
+	// The native type is always accepted
+	if reflect.TypeOf(value) == fieldType && info.Type != FieldTypeTimestamp {
+		return reflect.ValueOf(value), nil
+	}
+
+	switch info.Type {
+	case FieldTypeInt:
+		number, err := coerceInt(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if number < math.MinInt || number > math.MaxInt {
+			return reflect.Value{}, fmt.Errorf("%d is out of range", number)
+		}
+		return reflect.ValueOf(int(number)), nil
+
+	case FieldTypeUint64:
+		number, err := coerceUint(value)
+		return reflect.ValueOf(number), err
+
+	case FieldTypeNumber:
+		number, err := coerceFloat(value)
+		return reflect.ValueOf(number), err
+
+	case FieldTypeString:
+		str, ok := value.(string)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("got %T", value)
+		}
+		return reflect.ValueOf(str), nil
+
+	case FieldTypeUUID:
+		str, ok := value.(string)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("got %T", value)
+		}
+		id, err := uuid.Parse(str)
+		return reflect.ValueOf(id), err
+
+	case FieldTypeColorRGBA:
+		switch x := value.(type) {
+		case string:
+			color, err := NewColor(x)
+			return reflect.ValueOf(color), err
+		case []byte:
+			if len(x) != 3 && len(x) != 4 {
+				return reflect.Value{}, fmt.Errorf("got %d bytes", len(x))
+			}
+			return reflect.ValueOf(ColorRGBA(x)), nil
+		}
+		return reflect.Value{}, fmt.Errorf("got %T", value)
+
+	case FieldTypeTimestamp:
+		timestamp, err := coerceTimestamp(value)
+		return reflect.ValueOf(timestamp), err
+
+	case FieldTypeEnum:
+		return coerceEnum(fieldType, value)
+
+	case FieldTypeEnumArray:
+		var items []any
+		switch x := value.(type) {
+		case string:
+			for _, item := range strings.Split(x, ",") {
+				items = append(items, strings.TrimSpace(item))
+			}
+		default:
+			list := reflect.ValueOf(value)
+			if list.Kind() != reflect.Slice {
+				return reflect.Value{}, fmt.Errorf("got %T", value)
+			}
+			for idx := 0; idx < list.Len(); idx++ {
+				items = append(items, list.Index(idx).Interface())
+			}
+		}
+		result := reflect.MakeSlice(fieldType, 0, len(items))
+		for _, item := range items {
+			enumValue, err := coerceEnum(fieldType.Elem(), item)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			result = reflect.Append(result, enumValue)
+		}
+		return result, nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("unsupported field type %s", info.Type)
+}
+
+// coerceEnum converts a name or numeric value into the enumeration type
+func coerceEnum(enumType reflect.Type, value any) (reflect.Value, error) {
+	if reflect.TypeOf(value) == enumType {
+		return reflect.ValueOf(value), nil
+	}
+
+	result := reflect.New(enumType)
+	if str, ok := value.(string); ok {
+		if _, err := strconv.ParseUint(str, 10, 64); err != nil {
+			// Enumerations decode their names from JSON strings
+			quoted, _ := json.Marshal(str)
+			err := result.Interface().(json.Unmarshaler).UnmarshalJSON(quoted)
+			return result.Elem(), err
+		}
+	}
+
+	number, err := coerceUint(value)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	result.Elem().SetUint(number)
+	if result.Elem().Interface().(fmt.Stringer).String() == "" {
+		return reflect.Value{}, fmt.Errorf("unknown enumeration: %d", number)
+	}
+	return result.Elem(), nil
+}
+
+// coerceTimestamp converts time.Time, Unix time or an RFC3339 string to Unix time
+func coerceTimestamp(value any) (uint64, error) {
+	switch x := value.(type) {
+	case time.Time:
+		if x.Unix() < 0 {
+			return 0, fmt.Errorf("%s is before the Unix epoch", x)
+		}
+		return uint64(x.Unix()), nil
+	case string:
+		if parsed, err := time.Parse(time.RFC3339, x); err == nil {
+			return coerceTimestamp(parsed)
+		}
+	}
+	return coerceUint(value)
+}
+
+// coerceInt converts any integer type, integral float or string to int64
+func coerceInt(value any) (int64, error) {
+	number := reflect.ValueOf(value)
+	switch number.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if number.Uint() > math.MaxInt64 {
+			return 0, fmt.Errorf("%d is out of range", number.Uint())
+		}
+		return int64(number.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		if number.Float() != math.Trunc(number.Float()) || math.Abs(number.Float()) > math.MaxInt64 {
+			return 0, fmt.Errorf("%v is not an integer", number.Float())
+		}
+		return int64(number.Float()), nil
+	case reflect.String:
+		parsed, err := strconv.ParseInt(strings.TrimSpace(number.String()), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not an integer", number.String())
+		}
+		return parsed, nil
+	}
+	return 0, fmt.Errorf("got %T", value)
+}
+
+// coerceUint converts a non-negative value (see coerceInt) to uint64
+func coerceUint(value any) (uint64, error) {
+	number := reflect.ValueOf(value)
+	switch number.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return number.Uint(), nil
+	case reflect.String:
+		parsed, err := strconv.ParseUint(strings.TrimSpace(number.String()), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a non-negative integer", number.String())
+		}
+		return parsed, nil
+	}
+	signed, err := coerceInt(value)
+	if err != nil {
+		return 0, err
+	}
+	if signed < 0 {
+		return 0, fmt.Errorf("%d is negative", signed)
+	}
+	return uint64(signed), nil
+}
+
+// coerceFloat converts any numeric type or string to float64
+func coerceFloat(value any) (float64, error) {
+	number := reflect.ValueOf(value)
+	switch number.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return number.Float(), nil
+	case reflect.String:
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(number.String()), 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a number", number.String())
+		}
+		return parsed, nil
+	}
+	signed, err := coerceInt(value)
+	return float64(signed), err
+}
@@ -0,0 +1,156 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	"fmt"
+	"math"
+)
+
+// actualWeightTolerance is the relative difference between the actual and nominal
+// full weights beyond which the actual weight is considered implausible
+const actualWeightTolerance = 0.2
+
+// plausibilityRule is a check for physically implausible data, spanning fields and regions
+type plausibilityRule struct {
+	code RuleCode
+
+	// isError is true where the rule finds data that is certainly wrong, otherwise
+	// its findings are warnings
+	isError bool
+
+	// check returns each problem found
+	check func(o *OpenPrintTag) []plausibilityFinding
+}
+
+// plausibilityFinding is a single problem found by a plausibility rule
+type plausibilityFinding struct {
+	// field is the field concerned, nil where the problem spans fields
+	field   *FieldInfo
+	message string
+}
+
+// acrossFields adapts a check whose problems span fields, returning only messages
+func acrossFields(check func(o *OpenPrintTag) []string) func(o *OpenPrintTag) []plausibilityFinding {
+	return func(o *OpenPrintTag) (findings []plausibilityFinding) {
+		for _, message := range check(o) {
+			findings = append(findings, plausibilityFinding{message: message})
+		}
+		return
+	}
+}
+
+// plausibilityRules is the set of plausibility checks run by OptCheck
+var plausibilityRules = []plausibilityRule{
+	{RulePrintTemperatureInverted, true, acrossFields(inversionCheck("min_print_temperature", "max_print_temperature", "°C",
+		(*MainRegion).GetMinPrintTemperature, (*MainRegion).GetMaxPrintTemperature))},
+	{RuleBedTemperatureInverted, true, acrossFields(inversionCheck("min_bed_temperature", "max_bed_temperature", "°C",
+		(*MainRegion).GetMinBedTemperature, (*MainRegion).GetMaxBedTemperature))},
+	{RuleChamberTemperatureInverted, true, acrossFields(inversionCheck("min_chamber_temperature", "max_chamber_temperature", "°C",
+		(*MainRegion).GetMinChamberTemperature, (*MainRegion).GetMaxChamberTemperature))},
+	{RuleContainerDiameterInverted, true, acrossFields(func(o *OpenPrintTag) []string {
+		inner, innerFound := o.main.GetContainerInnerDiameter()
+		outer, outerFound := o.main.GetContainerOuterDiameter()
+		if innerFound && outerFound && inner >= outer {
+			return []string{fmt.Sprintf("container_inner_diameter of %d mm is not less than container_outer_diameter of %d mm", inner, outer)}
+		}
+		return nil
+	})},
+	{RulePreheatAbovePrintRange, false, acrossFields(func(o *OpenPrintTag) []string {
+		preheat, preheatFound := o.main.GetPreheatTemperature()
+		maximum, maxFound := o.main.GetMaxPrintTemperature()
+		if preheatFound && maxFound && preheat > maximum {
+			return []string{fmt.Sprintf("preheat_temperature of %d °C is above max_print_temperature of %d °C", preheat, maximum)}
+		}
+		return nil
+	})},
+	{RuleActualWeightDeviates, false, acrossFields(func(o *OpenPrintTag) []string {
+		actual, actualFound := o.main.GetActualNettoFullWeight()
+		nominal, nominalFound := o.main.GetNominalNettoFullWeight()
+		if actualFound && nominalFound && nominal > 0 && math.Abs(actual-nominal)/nominal > actualWeightTolerance {
+			return []string{fmt.Sprintf("actual_netto_full_weight of %g g differs from nominal_netto_full_weight of %g g by more than %.0f%%",
+				actual, nominal, actualWeightTolerance*100)}
+		}
+		return nil
+	})},
+	{RuleExpirationBeforeManufacture, true, acrossFields(func(o *OpenPrintTag) []string {
+		manufactured, manufacturedFound := o.main.GetManufacturedDate()
+		expiration, expirationFound := o.main.GetExpirationDate()
+		if manufacturedFound && expirationFound && expiration.Before(manufactured) {
+			return []string{fmt.Sprintf("expiration_date of %s is before manufactured_date of %s",
+				expiration.UTC().Format("2006-01-02"), manufactured.UTC().Format("2006-01-02"))}
+		}
+		return nil
+	})},
+	{RuleNegativeQuantity, true, negativeQuantityCheck},
+	{RuleLengthWeightMismatch, false, acrossFields((*OpenPrintTag).lengthWeightMismatches)},
+	{RuleMaterialTypeNotApplicable, false, materialTypeApplicabilityCheck},
+	{RuleFieldNotApplicable, false, fieldApplicabilityCheck},
+}
+
+// inversionCheck returns a check that the minimum of a range is not above its maximum
+func inversionCheck(minName, maxName, unit string, getMin, getMax func(*MainRegion) (int, bool)) func(o *OpenPrintTag) []string {
+	return func(o *OpenPrintTag) []string {
+		minimum, minFound := getMin(o.main)
+		maximum, maxFound := getMax(o.main)
+		if minFound && maxFound && minimum > maximum {
+			return []string{fmt.Sprintf("%s of %d %s is above %s of %d %s", minName, minimum, unit, maxName, maximum, unit)}
+		}
+		return nil
+	}
+}
+
+// negativeQuantityCheck flags negative weights, lengths and volumes in any region
+func negativeQuantityCheck(o *OpenPrintTag) (findings []plausibilityFinding) {
+	for _, fields := range [][]FieldInfo{metaFieldInfo, mainFieldInfo, auxFieldInfo} {
+		for _, info := range fields {
+			switch Unit(info.Unit) {
+			case UnitGram, UnitMillimeter, UnitMilliliter:
+			default:
+				continue
+			}
+			quantity, found, err := o.GetQuantity(info.Region + "." + info.Name)
+			if err == nil && found && quantity.Value < 0 {
+				info := info
+				findings = append(findings, plausibilityFinding{field: &info, message: fmt.Sprintf("%s.%s of %s is negative", info.Region, info.Name, quantity)})
+			}
+		}
+	}
+	return
+}
+
+// plausibilityCheck runs all plausibility rules, adding their findings to the report
+func (o *OpenPrintTag) plausibilityCheck(report *Report) {
+	for _, rule := range plausibilityRules {
+		severity := SeverityWarning
+		if rule.isError {
+			severity = SeverityError
+		}
+		for _, finding := range rule.check(o) {
+			if finding.field != nil {
+				report.addField(severity, rule.code, *finding.field, "%s", finding.message)
+			} else {
+				report.add(severity, rule.code, "%s", finding.message)
+			}
+		}
+	}
+}
@@ -0,0 +1,228 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	"slices"
+	"strings"
+)
+
+// CCForm identifies the form of capability container used by a tag
+type CCForm int
+
+const (
+	// CCFormNFCV is the 4 byte NFC-V (Type 5) capability container
+	CCFormNFCV CCForm = iota
+	// CCFormNFCVExtended is the 8 byte NFC-V capability container, used beyond 2040 bytes
+	CCFormNFCVExtended
+	// CCFormType2 is the NFC Forum Type 2 capability container, see Type2Layout
+	CCFormType2
+)
+
+// String returns a description of the CC form
+func (c CCForm) String() string {
+	switch c {
+	case CCFormNFCV:
+		return "NFC-V"
+	case CCFormNFCVExtended:
+		return "NFC-V extended"
+	case CCFormType2:
+		return "Type 2"
+	default:
+		return "unknown"
+	}
+}
+
+// TagProfile describes a physical tag, giving the parameters needed to format it
+type TagProfile struct {
+	// Name is the short name of the profile, as used by LookupProfile
+	Name string `yaml:"name" json:"name"`
+
+	// Description is the full name of the tag
+	Description string `yaml:"description" json:"description"`
+
+	// Size is the usable memory, as passed to WithSize
+	Size int `yaml:"size" json:"size"`
+
+	// BlockSize is the size of a memory block (or page) on the tag
+	BlockSize int `yaml:"block_size" json:"block_size"`
+
+	// CCForm is the form of capability container used on the tag
+	CCForm CCForm `yaml:"cc_form" json:"cc_form"`
+
+	// AuxRegionSize is the recommended aux region size, 0 where the tag is too small to spare one
+	AuxRegionSize int `yaml:"aux_region_size" json:"aux_region_size"`
+}
+
+// profiles is the registry of known tag profiles, in the order they are listed
+var profiles = []TagProfile{
+	{Name: "sli", Description: "NXP ICODE SLI", Size: 112, BlockSize: 4, CCForm: CCFormNFCV},
+	{Name: "slix", Description: "NXP ICODE SLIX", Size: 112, BlockSize: 4, CCForm: CCFormNFCV},
+	{Name: "slix2", Description: "NXP ICODE SLIX2", Size: 312, BlockSize: 4, CCForm: CCFormNFCV, AuxRegionSize: 32},
+	{Name: "st25dv04k", Description: "ST ST25DV04K", Size: 512, BlockSize: 4, CCForm: CCFormNFCV, AuxRegionSize: 32},
+	{Name: "st25dv16k", Description: "ST ST25DV16K", Size: 2048, BlockSize: 4, CCForm: CCFormNFCVExtended, AuxRegionSize: 64},
+	{Name: "st25dv64k", Description: "ST ST25DV64K", Size: 8192, BlockSize: 4, CCForm: CCFormNFCVExtended, AuxRegionSize: 64},
+	{Name: "ntag213", Description: "NXP NTAG213", Size: NTAG213.ImageSize(), BlockSize: type2PageSize, CCForm: CCFormType2},
+	{Name: "ntag215", Description: "NXP NTAG215", Size: NTAG215.ImageSize(), BlockSize: type2PageSize, CCForm: CCFormType2, AuxRegionSize: 32},
+	{Name: "ntag216", Description: "NXP NTAG216", Size: NTAG216.ImageSize(), BlockSize: type2PageSize, CCForm: CCFormType2, AuxRegionSize: 32},
+}
+
+// Profiles returns all registered tag profiles
+func Profiles() []TagProfile {
+	return slices.Clone(profiles)
+}
+
+// LookupProfile finds a registered profile by name, ignoring case
+func LookupProfile(name string) (TagProfile, bool) {
+	for _, profile := range profiles {
+		if strings.EqualFold(profile.Name, name) {
+			return profile, true
+		}
+	}
+	return TagProfile{}, false
+}
+
+// RegisterProfile adds a profile to the registry, replacing any existing profile of the same name
+func RegisterProfile(profile TagProfile) {
+	for idx := range profiles {
+		if strings.EqualFold(profiles[idx].Name, profile.Name) {
+			profiles[idx] = profile
+			return
+		}
+	}
+	profiles = append(profiles, profile)
+}
+
+// EncodeDecodeOptions returns the options required to encode or decode a tag of this profile
+func (p TagProfile) EncodeDecodeOptions() []EncodeDecodeOption {
+	if p.CCForm == CCFormType2 {
+		return []EncodeDecodeOption{Type2Layout}
+	}
+	return nil
+}
+
+// WithProfile sizes the tag for a profile, setting the tag size, block size
+// and (where the profile recommends one) the aux region size
+// Profiles with the Type 2 CC form must be encoded with the options from EncodeDecodeOptions
+func (o *OpenPrintTag) WithProfile(profile TagProfile) *OpenPrintTag {
+	o.WithSize(profile.Size).WithBlockSize(profile.BlockSize)
+	if profile.AuxRegionSize > 0 {
+		o.WithAuxRegionSize(profile.AuxRegionSize)
+	}
+	return o
+}
+
+// ProfileFit reports whether the data of an encoded tag would fit on a profile
+type ProfileFit struct {
+	Profile TagProfile `yaml:"profile" json:"profile"`
+
+	// Required is the minimum number of bytes needed on this profile
+	Required int `yaml:"required" json:"required"`
+
+	// Fits is true where the tag was successfully encoded on the profile
+	Fits bool `yaml:"fits" json:"fits"`
+}
+
+// RequiredSize returns an estimate of the number of bytes needed to hold the data described by
+// stats (from GetStats) on a tag of this profile. This covers the data actually used within each
+// region, the aux region reserved by the profile (aligned to its block size), the other NDEF records,
+// and the CC and TLV overhead of the profile.
+func (p TagProfile) RequiredSize(stats *Stats) int {
+	// Records other than ours are carried across unchanged, ours shrinks to the used payload
+	otherRecords := stats.Root.NDEFOverhead - optRecordHeaderSize(stats.Root.PayloadSize)
+	payload := stats.Root.PayloadUsedSize
+
+	// The aux region is placed at the end of the payload, aligned down to a block boundary,
+	// and occupies at least the size reserved by the profile
+	if p.AuxRegionSize > 0 || stats.Aux != nil {
+		auxUsed := 0
+		if stats.Aux != nil {
+			auxUsed = stats.Aux.UsedSize
+		}
+		payload += max(p.AuxRegionSize-auxUsed, 0) + p.BlockSize - 1
+	}
+	ndefMessage := otherRecords + optRecordHeaderSize(payload) + payload
+
+	ndefTLVHeader := 2
+	if ndefMessage > 0xFE {
+		ndefTLVHeader = 4
+	}
+
+	ccSize := 4
+	preambleTLVs := 0
+	switch p.CCForm {
+	case CCFormNFCVExtended:
+		ccSize = extendedCCSize
+	case CCFormType2:
+		preambleTLVs = len(type2LockControlTLV(p.Size - type2CCSize))
+	}
+
+	return ccSize + preambleTLVs + ndefTLVHeader + ndefMessage + 1
+}
+
+// fitsOnMeasureSize is the tag size used to measure the data for FitsOn, that of the largest registered profile
+const fitsOnMeasureSize = 8192
+
+// FitsOn reports, for every registered profile, whether the tag's data would fit, by encoding it
+// as sized by WithProfile. The required size is estimated by RequiredSize.
+// The tag is left unchanged, an error is returned only where the data cannot be measured
+func (o *OpenPrintTag) FitsOn(opts ...EncodeDecodeOption) ([]ProfileFit, error) {
+	restore := o.snapshotForTrial()
+	defer restore()
+
+	// Measure the data without the constraints of any profile
+	o.meta.ClearAuxRegionOffset()
+	if _, err := o.WithSize(fitsOnMeasureSize).Encode(opts...); err != nil {
+		return nil, err
+	}
+	stats := *o.stats
+
+	fits := make([]ProfileFit, 0, len(profiles))
+	for _, profile := range profiles {
+		restore()
+		o.meta.ClearAuxRegionOffset()
+		_, err := o.WithProfile(profile).Encode(append(profile.EncodeDecodeOptions(), opts...)...)
+		fits = append(fits, ProfileFit{Profile: profile, Required: profile.RequiredSize(&stats), Fits: err == nil})
+	}
+	return fits, nil
+}
+
+// snapshotForTrial records the tag sizing, stats and region data, returning a function that
+// restores them after trial encodes
+func (o *OpenPrintTag) snapshotForTrial() (restore func()) {
+	restoreRegions := o.snapshotRegions()
+	aux, size, blockSize, auxRegionSize, stats := o.aux, o.size, o.blockSize, o.auxRegionSize, o.stats
+	return func() {
+		o.aux, o.size, o.blockSize, o.auxRegionSize, o.stats = aux, size, blockSize, auxRegionSize, stats
+		restoreRegions()
+	}
+}
+
+// optRecordHeaderSize is the size of the open print tag NDEF record header for a given payload size
+// payloads beyond 255 bytes need a 4 byte length
+func optRecordHeaderSize(payloadSize int) int {
+	size := 3 + len(mimeType)
+	if payloadSize > 255 {
+		size += 3
+	}
+	return size
+}
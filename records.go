@@ -0,0 +1,208 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/hsanjuan/go-ndef"
+	"github.com/hsanjuan/go-ndef/types/generic"
+	"github.com/hsanjuan/go-ndef/types/wkt/text"
+	"github.com/hsanjuan/go-ndef/types/wkt/uri"
+)
+
+// NDEF type name formats used by the record constructors
+const (
+	TNFWellKnown = 0x01
+	TNFMedia     = 0x02
+	TNFExternal  = 0x04
+)
+
+// aarType is the external type of an Android Application Record
+const aarType = "android.com:pkg"
+
+// NDEFRecord is an NDEF record carried on the tag alongside the open print tag record
+// The payload is held in its raw form, so records of any type survive decode and encode unchanged
+type NDEFRecord struct {
+	// TNF is the type name format
+	TNF byte
+
+	// Type is the record type, for example "U", "T" or a mime type
+	Type string
+
+	// ID is the optional record ID
+	ID string
+
+	// Payload is the raw record payload
+	Payload []byte
+}
+
+// NewURIRecord creates a well known URI record
+func NewURIRecord(uriValue string) NDEFRecord {
+	return NDEFRecord{TNF: TNFWellKnown, Type: "U", Payload: uri.New(uriValue).Marshal()}
+}
+
+// NewTextRecord creates a well known text record, language is an IETF language code such as "en"
+func NewTextRecord(textValue, language string) NDEFRecord {
+	return NDEFRecord{TNF: TNFWellKnown, Type: "T", Payload: text.New(textValue, language).Marshal()}
+}
+
+// NewAARRecord creates an Android Application Record, naming the package that should handle the tag
+func NewAARRecord(packageName string) NDEFRecord {
+	return NDEFRecord{TNF: TNFExternal, Type: aarType, Payload: []byte(packageName)}
+}
+
+// NewMIMERecord creates a media record of the given mime type
+func NewMIMERecord(mimeType string, payload []byte) NDEFRecord {
+	return NDEFRecord{TNF: TNFMedia, Type: mimeType, Payload: bytes.Clone(payload)}
+}
+
+// URI returns the URI held by a URI record
+func (r NDEFRecord) URI() (string, bool) {
+	if r.TNF != TNFWellKnown || r.Type != "U" {
+		return "", false
+	}
+	p := &uri.Payload{}
+	p.Unmarshal(r.Payload)
+	return p.String(), true
+}
+
+// Text returns the text and language held by a text record
+func (r NDEFRecord) Text() (textValue string, language string, ok bool) {
+	if r.TNF != TNFWellKnown || r.Type != "T" {
+		return "", "", false
+	}
+	p := &text.Payload{}
+	p.Unmarshal(r.Payload)
+	return p.Text, p.Language, true
+}
+
+// AARPackage returns the package name held by an Android Application Record
+func (r NDEFRecord) AARPackage() (string, bool) {
+	if r.TNF != TNFExternal || r.Type != aarType {
+		return "", false
+	}
+	return string(r.Payload), true
+}
+
+// toNDEF converts the record into its go-ndef form
+func (r NDEFRecord) toNDEF() *ndef.Record {
+	return ndef.NewRecord(r.TNF, r.Type, r.ID, &generic.Payload{Payload: r.Payload})
+}
+
+// newNDEFRecord captures a decoded go-ndef record
+func newNDEFRecord(rec *ndef.Record) (NDEFRecord, error) {
+	payload, err := rec.Payload()
+	if err != nil {
+		return NDEFRecord{}, err
+	}
+	return NDEFRecord{TNF: rec.TNF(), Type: rec.Type(), ID: rec.ID(), Payload: payload.Marshal()}, nil
+}
+
+// Records returns the NDEF records carried alongside the open print tag record, in tag order
+func (o *OpenPrintTag) Records() []NDEFRecord {
+	return slices.Clone(o.records)
+}
+
+// RecordsBeforeOpenPrintTag returns the number of records (from Records) that preceed the
+// open print tag record, the remainder follow it
+func (o *OpenPrintTag) RecordsBeforeOpenPrintTag() int {
+	return o.recordsBefore
+}
+
+// WithRecord adds a record, placing it after any other records that preceed the open print tag record
+func (o *OpenPrintTag) WithRecord(record NDEFRecord) *OpenPrintTag {
+	o.records = slices.Insert(o.records, o.recordsBefore, record)
+	o.recordsBefore++
+	return o
+}
+
+// WithTrailingRecord adds a record after the open print tag record, and any records already following it
+func (o *OpenPrintTag) WithTrailingRecord(record NDEFRecord) *OpenPrintTag {
+	o.records = append(o.records, record)
+	return o
+}
+
+// WithTextRecord adds a text record preceeding the open print tag record
+func (o *OpenPrintTag) WithTextRecord(textValue, language string) *OpenPrintTag {
+	return o.WithRecord(NewTextRecord(textValue, language))
+}
+
+// WithAARRecord adds an Android Application Record, which by convention follows all other records
+func (o *OpenPrintTag) WithAARRecord(packageName string) *OpenPrintTag {
+	return o.WithTrailingRecord(NewAARRecord(packageName))
+}
+
+// RemoveRecords removes all records other than the open print tag record
+func (o *OpenPrintTag) RemoveRecords() *OpenPrintTag {
+	o.records = nil
+	o.recordsBefore = 0
+	return o
+}
+
+// uriRecord returns the URI from the first URI record, if any
+func (o *OpenPrintTag) uriRecord() string {
+	for _, record := range o.records {
+		if uriValue, ok := record.URI(); ok {
+			return uriValue
+		}
+	}
+	return ""
+}
+
+// setURIRecord replaces the first URI record, or adds one ahead of all other records
+// An empty URI removes the first URI record
+func (o *OpenPrintTag) setURIRecord(uriValue string) {
+	for idx, record := range o.records {
+		if _, ok := record.URI(); !ok {
+			continue
+		}
+		if uriValue != "" {
+			o.records[idx] = NewURIRecord(uriValue)
+			return
+		}
+		o.records = slices.Delete(o.records, idx, idx+1)
+		if idx < o.recordsBefore {
+			o.recordsBefore--
+		}
+		return
+	}
+	if uriValue == "" {
+		return
+	}
+	o.records = slices.Insert(o.records, 0, NewURIRecord(uriValue))
+	o.recordsBefore++
+}
+
+// marshalRecords encodes a list of records as they would appear within a message, returning their size
+func marshalRecords(records []NDEFRecord) (int, error) {
+	if len(records) == 0 {
+		return 0, nil
+	}
+	ndefRecords := make([]*ndef.Record, 0, len(records))
+	for _, record := range records {
+		ndefRecords = append(ndefRecords, record.toNDEF())
+	}
+	data, err := ndef.NewMessageFromRecords(ndefRecords...).Marshal()
+	return len(data), err
+}
@@ -0,0 +1,263 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Severity is the severity of an Issue
+type Severity int
+
+const (
+	// SeverityNote is informational, such as a UUID that will be derived from other fields
+	SeverityNote Severity = iota
+
+	// SeverityWarning is data that is permitted, but unlikely to be intended or wasteful
+	SeverityWarning
+
+	// SeverityError is data that is invalid
+	SeverityError
+)
+
+var severityNames = map[Severity]string{
+	SeverityNote:    "note",
+	SeverityWarning: "warning",
+	SeverityError:   "error",
+}
+
+func (s Severity) String() string {
+	if name, ok := severityNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("%d", s)
+}
+
+// ParseSeverity parses a severity from its name (note, warning or error)
+func ParseSeverity(str string) (Severity, error) {
+	for severity, name := range severityNames {
+		if name == str {
+			return severity, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown severity: %s", str)
+}
+
+func (s Severity) MarshalYAML() (any, error) {
+	return s.String(), nil
+}
+
+func (s *Severity) UnmarshalYAML(value *yaml.Node) error {
+	var str string
+	if err := value.Decode(&str); err != nil {
+		return err
+	}
+	severity, err := ParseSeverity(str)
+	if err != nil {
+		return err
+	}
+	*s = severity
+	return nil
+}
+
+func (s Severity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+func (s *Severity) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	severity, err := ParseSeverity(str)
+	if err != nil {
+		return err
+	}
+	*s = severity
+	return nil
+}
+
+// RuleCode is the stable identifier of a check, given with each Issue it produces
+// so that findings can be recognized regardless of wording
+type RuleCode string
+
+const (
+	// Field, region and UUID checks
+	RuleRequiredFieldMissing    RuleCode = "required_field_missing"
+	RuleRecommendedFieldMissing RuleCode = "recommended_field_missing"
+	RuleMaxLengthExceeded       RuleCode = "max_length_exceeded"
+	RuleInvalidRGBALength       RuleCode = "invalid_rgba_length"
+	RuleDeprecatedField         RuleCode = "deprecated_field"
+	RuleUnknownEnumValue        RuleCode = "unknown_enum_value"
+	RuleDeprecatedEnumValue     RuleCode = "deprecated_enum_value"
+	RuleInvalidGTIN             RuleCode = "invalid_gtin"
+	RuleAuxRegionSize           RuleCode = "aux_region_size"
+	RuleAuxRegionMissing        RuleCode = "aux_region_missing"
+	RuleUUIDRedundant           RuleCode = "uuid_redundant"
+	RuleUUIDDerived             RuleCode = "uuid_derived"
+	RuleUUIDNotDeducible        RuleCode = "uuid_not_deducible"
+	RuleInternalError           RuleCode = "internal_error"
+
+	// Plausibility checks across fields
+	RulePrintTemperatureInverted    RuleCode = "print_temperature_inverted"
+	RuleBedTemperatureInverted      RuleCode = "bed_temperature_inverted"
+	RuleChamberTemperatureInverted  RuleCode = "chamber_temperature_inverted"
+	RulePreheatAbovePrintRange      RuleCode = "preheat_above_print_range"
+	RuleActualWeightDeviates        RuleCode = "actual_weight_deviates"
+	RuleExpirationBeforeManufacture RuleCode = "expiration_before_manufacture"
+	RuleContainerDiameterInverted   RuleCode = "container_diameter_inverted"
+	RuleNegativeQuantity            RuleCode = "negative_quantity"
+	RuleLengthWeightMismatch        RuleCode = "length_weight_mismatch"
+	RuleMaterialTypeNotApplicable   RuleCode = "material_type_not_applicable"
+	RuleFieldNotApplicable          RuleCode = "field_not_applicable"
+)
+
+// Issue is a single finding of Validate or OptCheck
+type Issue struct {
+	Severity Severity `yaml:"severity" json:"severity"`
+
+	// Code identifies the check that found the issue, and is stable regardless of wording
+	Code RuleCode `yaml:"code" json:"code"`
+
+	// Region, Field (the native field name) and Key (the CBOR key) identify the field
+	// concerned, where the issue concerns a single field
+	Region string `yaml:"region,omitempty" json:"region,omitempty"`
+	Field  string `yaml:"field,omitempty" json:"field,omitempty"`
+	Key    *int   `yaml:"key,omitempty" json:"key,omitempty"`
+
+	// Message is the human readable description
+	Message string `yaml:"message" json:"message"`
+}
+
+func (i Issue) String() string {
+	return i.Message
+}
+
+// issueFields decodes the fields of an Issue without recursing into its decoders
+type issueFields Issue
+
+// UnmarshalYAML decodes an issue, also accepting a plain message as written to the
+// validate and opt_check sections by earlier versions
+func (i *Issue) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*i = Issue{Message: value.Value}
+		return nil
+	}
+	return value.Decode((*issueFields)(i))
+}
+
+// UnmarshalJSON decodes an issue, also accepting a plain message as written to the
+// validate and opt_check sections by earlier versions
+func (i *Issue) UnmarshalJSON(data []byte) error {
+	var message string
+	if err := json.Unmarshal(data, &message); err == nil {
+		*i = Issue{Message: message}
+		return nil
+	}
+	return json.Unmarshal(data, (*issueFields)(i))
+}
+
+// Report holds the issues found by Validate or OptCheck, in the order found
+type Report struct {
+	Issues []Issue `yaml:"issues" json:"issues"`
+}
+
+// add appends an issue that does not concern a single field
+func (r *Report) add(severity Severity, code RuleCode, format string, v ...any) {
+	r.Issues = append(r.Issues, Issue{Severity: severity, Code: code, Message: fmt.Sprintf(format, v...)})
+}
+
+// addField appends an issue concerning a single field
+func (r *Report) addField(severity Severity, code RuleCode, info FieldInfo, format string, v ...any) {
+	key := info.Key
+	r.Issues = append(r.Issues, Issue{
+		Severity: severity,
+		Code:     code,
+		Region:   info.Region,
+		Field:    info.Name,
+		Key:      &key,
+		Message:  fmt.Sprintf(format, v...),
+	})
+}
+
+// Filter returns a report holding only the issues for which keep returns true
+func (r *Report) Filter(keep func(issue Issue) bool) *Report {
+	filtered := &Report{}
+	for _, issue := range r.Issues {
+		if keep(issue) {
+			filtered.Issues = append(filtered.Issues, issue)
+		}
+	}
+	return filtered
+}
+
+// WithSeverity returns a report holding only the issues of the given severities
+func (r *Report) WithSeverity(severities ...Severity) *Report {
+	return r.Filter(func(issue Issue) bool { return slices.Contains(severities, issue.Severity) })
+}
+
+// WithCode returns a report holding only the issues with the given rule codes
+func (r *Report) WithCode(codes ...RuleCode) *Report {
+	return r.Filter(func(issue Issue) bool { return slices.Contains(codes, issue.Code) })
+}
+
+// InRegion returns a report holding only the issues concerning a field of the region
+func (r *Report) InRegion(region string) *Report {
+	return r.Filter(func(issue Issue) bool { return issue.Region == region })
+}
+
+// HasErrors returns true if the report holds any error
+func (r *Report) HasErrors() bool {
+	return slices.ContainsFunc(r.Issues, func(issue Issue) bool { return issue.Severity == SeverityError })
+}
+
+// Messages returns the messages of the issues of the given severity, nil if there are none
+func (r *Report) Messages(severity Severity) (messages []string) {
+	for _, issue := range r.Issues {
+		if issue.Severity == severity {
+			messages = append(messages, issue.Message)
+		}
+	}
+	return
+}
+
+// ToYAML returns a YAML representation of the report
+func (r *Report) ToYAML() (string, error) {
+	out, err := yaml.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// ToJSON returns a JSON representation of the report
+func (r *Report) ToJSON() (string, error) {
+	out, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
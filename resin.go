@@ -0,0 +1,143 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// DefaultStirInterval is the stirring interval used by StirringDue unless set with WithStirInterval
+const DefaultStirInterval = 24 * time.Hour
+
+// ViscosityPoint is a viscosity measurement given by the tag
+type ViscosityPoint struct {
+	// Temperature in °C
+	Temperature float64
+
+	// Viscosity in mPa·s
+	Viscosity float64
+}
+
+// ViscosityPoints returns the viscosities set on the tag (viscosity_18c, viscosity_25c,
+// viscosity_40c and viscosity_60c), in order of temperature
+func (o *OpenPrintTag) ViscosityPoints() []ViscosityPoint {
+	var points []ViscosityPoint
+	for _, source := range []struct {
+		temperature float64
+		getter      func() (float64, bool)
+	}{
+		{18, o.main.GetViscosity18C},
+		{25, o.main.GetViscosity25C},
+		{40, o.main.GetViscosity40C},
+		{60, o.main.GetViscosity60C},
+	} {
+		if viscosity, found := source.getter(); found && viscosity > 0 {
+			points = append(points, ViscosityPoint{Temperature: source.temperature, Viscosity: viscosity})
+		}
+	}
+	return points
+}
+
+// ViscosityAt returns the viscosity in mPa·s at a temperature in °C, interpolated between
+// the nearest viscosity points. As viscosity falls roughly exponentially with temperature
+// the logarithm of viscosity is interpolated linearly. Temperatures outside of the points
+// are extrapolated from the nearest two points. At least two points are required, unless
+// the temperature is that of the only point
+func (o *OpenPrintTag) ViscosityAt(temperature float64) (float64, bool) {
+	points := o.ViscosityPoints()
+	if idx := slices.IndexFunc(points, func(p ViscosityPoint) bool { return p.Temperature == temperature }); idx >= 0 {
+		return points[idx].Viscosity, true
+	}
+	if len(points) < 2 {
+		return 0, false
+	}
+
+	// Find the first point above the temperature, limited so that there is always a point below
+	upper := slices.IndexFunc(points, func(p ViscosityPoint) bool { return p.Temperature > temperature })
+	if upper < 1 {
+		if upper == 0 {
+			upper = 1
+		} else {
+			upper = len(points) - 1
+		}
+	}
+	low, high := points[upper-1], points[upper]
+
+	fraction := (temperature - low.Temperature) / (high.Temperature - low.Temperature)
+	logViscosity := math.Log(low.Viscosity) + fraction*(math.Log(high.Viscosity)-math.Log(low.Viscosity))
+	return math.Exp(logViscosity), true
+}
+
+// RemainingVolume returns the volume of resin remaining in ml, being the
+// container_volumetric_capacity less the volume of the aux consumed_weight
+// (calculated using the density)
+func (o *OpenPrintTag) RemainingVolume() (float64, bool) {
+	capacity, found := o.main.GetContainerVolumetricCapacity()
+	if !found {
+		return 0, false
+	}
+	consumed, found := o.consumedWeight()
+	if !found {
+		return capacity, true
+	}
+	density, found := o.main.GetDensity()
+	if !found || density <= 0 {
+		return 0, false
+	}
+	// g / (g/cm³) is cm³, which is ml
+	return math.Max(capacity-consumed/density, 0), true
+}
+
+// WithStirInterval sets the interval after which resin should be stirred again, used by StirringDue
+func (o *OpenPrintTag) WithStirInterval(interval time.Duration) *OpenPrintTag {
+	o.stirInterval = interval
+	return o
+}
+
+// StirInterval returns the stirring interval set with WithStirInterval, or DefaultStirInterval
+func (o *OpenPrintTag) StirInterval() time.Duration {
+	if o.stirInterval <= 0 {
+		return DefaultStirInterval
+	}
+	return o.stirInterval
+}
+
+// TimeSinceStir returns the time elapsed since the aux last_stir_time
+func (o *OpenPrintTag) TimeSinceStir() (time.Duration, bool) {
+	if o.aux == nil {
+		return 0, false
+	}
+	stirred, found := o.aux.GetLastStirTime()
+	if !found {
+		return 0, false
+	}
+	return time.Since(stirred), true
+}
+
+// StirringDue returns true if the resin was last stirred longer ago than the stirring
+// interval (see WithStirInterval), or if there is no record of it having been stirred
+func (o *OpenPrintTag) StirringDue() bool {
+	elapsed, found := o.TimeSinceStir()
+	return !found || elapsed > o.StirInterval()
+}
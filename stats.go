@@ -22,18 +22,25 @@
 package openprinttag
 
 type RootStat struct {
-	DataSize        int `yaml:"data_size"`
-	PayloadSize     int `yaml:"payload_size"`
-	Overhead        int `yaml:"overhead"`
-	PayloadUsedSize int `yaml:"payload_used_size"`
-	TotalUsedSize   int `yaml:"total_used_size"`
+	DataSize    int `yaml:"data_size" json:"data_size"`
+	PayloadSize int `yaml:"payload_size" json:"payload_size"`
+	Overhead    int `yaml:"overhead" json:"overhead"`
+
+	// CapabilityContainerSize, TLVOverhead and NDEFOverhead break down the overhead
+	// between the CC, the TLV structure and the NDEF records and headers
+	CapabilityContainerSize int `yaml:"capability_container_size" json:"capability_container_size"`
+	TLVOverhead             int `yaml:"tlv_overhead" json:"tlv_overhead"`
+	NDEFOverhead            int `yaml:"ndef_overhead" json:"ndef_overhead"`
+
+	PayloadUsedSize int `yaml:"payload_used_size" json:"payload_used_size"`
+	TotalUsedSize   int `yaml:"total_used_size" json:"total_used_size"`
 }
 
 type RegionStat struct {
-	PayloadOffset  int `yaml:"payload_offset"`
-	AbsoluteOffset int `yaml:"absolute_offset"`
-	Size           int `yaml:"size"`
-	UsedSize       int `yaml:"used_size"`
+	PayloadOffset  int `yaml:"payload_offset" json:"payload_offset"`
+	AbsoluteOffset int `yaml:"absolute_offset" json:"absolute_offset"`
+	Size           int `yaml:"size" json:"size"`
+	UsedSize       int `yaml:"used_size" json:"used_size"`
 }
 
 type Stats struct {
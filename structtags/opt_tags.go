@@ -32,4 +32,5 @@ const (
 	OptTagRecommended = "recommended"
 	OptTagMaxLength   = "max_length"
 	OptTagRGBA        = "rgba"
+	OptTagDeprecated  = "deprecated"
 )
@@ -24,6 +24,7 @@ package openprinttag
 // ** THIS FILE IS AUTO-GENERATED, DO NOT MODIFY **
 
 import (
+	"encoding/json"
 	"fmt"
 	"gopkg.in/yaml.v3"
 )
@@ -364,6 +365,339 @@ var TagMap = map[uint64]string{
 	69: "limited_edition",
 }
 
+// tagInfo holds the specification information for all Tag values
+var tagInfo = []EnumInfo{
+	{
+		Key:         0,
+		Name:        "filtration_recommended",
+		Description: "Releases a higher concentration of unsafe particles/fumes during printing so a HEPA and carbon filter is strongly recommended.",
+	},
+	{
+		Key:         1,
+		Name:        "biocompatible",
+		Description: "Certified biocompatibility (does not cause harmful effects when in contact with the body).",
+	},
+	{
+		Key:         61,
+		Name:        "home_compostable",
+		Description: "Decomposes into natural elements in a home compost system at ambient temperatures.",
+	},
+	{
+		Key:         62,
+		Name:        "industrially_compostable",
+		Description: "Decomposes into natural elements under specific temperature and microbial conditions in commercial composting facilities.",
+	},
+	{
+		Key:         63,
+		Name:        "bio_based",
+		Description: "Predominantly made from renewable biological resources, like plants.",
+	},
+	{
+		Key:         2,
+		Name:        "antibacterial",
+		Description: "Has antibacterial properties.",
+	},
+	{
+		Key:         3,
+		Name:        "air_filtering",
+		Description: "Has air filtering properties (absorbs/filters harmful compounds/particles from the air).",
+	},
+	{
+		Key:         4,
+		Name:        "abrasive",
+		Description: "The material is abrasive and requires an abrasive-resistant nozzle.",
+	},
+	{
+		Key:         5,
+		Name:        "foaming",
+		Description: "The material increases its volume during extrusion.",
+	},
+	{
+		Key:  67,
+		Name: "castable",
+	},
+	{
+		Key:  6,
+		Name: "self_extinguishing",
+	},
+	{
+		Key:         7,
+		Name:        "paramagnetic",
+		Description: "The material has paramagnetic properties, meaning that it is (weakly) attracted to magnets.",
+	},
+	{
+		Key:         8,
+		Name:        "radiation_shielding",
+		Description: "Has radiation shielding properties.",
+	},
+	{
+		Key:  9,
+		Name: "high_temperature",
+	},
+	{
+		Key:  71,
+		Name: "high_speed",
+	},
+	{
+		Key:  10,
+		Name: "esd_safe",
+	},
+	{
+		Key:  11,
+		Name: "conductive",
+	},
+	{
+		Key:  70,
+		Name: "emi_shielding",
+	},
+	{
+		Key:         12,
+		Name:        "blend",
+		Description: "The material is a blend of multiple polymers or a base polymer with significant additives that alter its properties and may require a specific print profile.",
+	},
+	{
+		Key:         13,
+		Name:        "water_soluble",
+		Description: "Can be dissolved in water.",
+	},
+	{
+		Key:         14,
+		Name:        "ipa_soluble",
+		Description: "Can be dissolved in IPA (isopropyl alcohol).",
+	},
+	{
+		Key:         15,
+		Name:        "limonene_soluble",
+		Description: "Can be dissolved in limonene.",
+	},
+	{
+		Key:         64,
+		Name:        "low_outgassing",
+		Description: "Releases only minimal gas (and vapor) when placed in a vacuum.",
+	},
+	{
+		Key:  16,
+		Name: "matte",
+	},
+	{
+		Key:  17,
+		Name: "silk",
+	},
+	{
+		Key:  19,
+		Name: "translucent",
+	},
+	{
+		Key:  20,
+		Name: "transparent",
+	},
+	{
+		Key:  65,
+		Name: "without_pigments",
+	},
+	{
+		Key:  21,
+		Name: "iridescent",
+	},
+	{
+		Key:  22,
+		Name: "pearlescent",
+	},
+	{
+		Key:  23,
+		Name: "glitter",
+	},
+	{
+		Key:  24,
+		Name: "glow_in_the_dark",
+	},
+	{
+		Key:  25,
+		Name: "neon",
+	},
+	{
+		Key:  26,
+		Name: "illuminescent_color_change",
+	},
+	{
+		Key:  27,
+		Name: "temperature_color_change",
+	},
+	{
+		Key:  28,
+		Name: "gradual_color_change",
+	},
+	{
+		Key:  29,
+		Name: "coextruded",
+	},
+	{
+		Key:  30,
+		Name: "contains_carbon",
+	},
+	{
+		Key:  31,
+		Name: "contains_carbon_fiber",
+	},
+	{
+		Key:  32,
+		Name: "contains_carbon_nano_tubes",
+	},
+	{
+		Key:  72,
+		Name: "contains_graphene",
+	},
+	{
+		Key:  33,
+		Name: "contains_glass",
+	},
+	{
+		Key:  34,
+		Name: "contains_glass_fiber",
+	},
+	{
+		Key:  35,
+		Name: "contains_kevlar",
+	},
+	{
+		Key:  68,
+		Name: "contains_ptfe",
+	},
+	{
+		Key:  36,
+		Name: "contains_stone",
+	},
+	{
+		Key:  37,
+		Name: "contains_magnetite",
+	},
+	{
+		Key:         38,
+		Name:        "contains_organic_material",
+		Description: "Contains organic material.",
+	},
+	{
+		Key:         39,
+		Name:        "contains_cork",
+		Description: "Contains cork.",
+	},
+	{
+		Key:         40,
+		Name:        "contains_wax",
+		Description: "Contains wax.",
+	},
+	{
+		Key:         41,
+		Name:        "contains_wood",
+		Description: "Contains wood.",
+	},
+	{
+		Key:         66,
+		Name:        "contains_algae",
+		Description: "Contains algae.",
+	},
+	{
+		Key:         42,
+		Name:        "contains_bamboo",
+		Description: "Contains bamboo.",
+	},
+	{
+		Key:         43,
+		Name:        "contains_pine",
+		Description: "Contains pine.",
+	},
+	{
+		Key:         44,
+		Name:        "contains_ceramic",
+		Description: "Contains ceramic.",
+	},
+	{
+		Key:         45,
+		Name:        "contains_boron_carbide",
+		Description: "Contains boron carbide (useful for radiation shielding).",
+	},
+	{
+		Key:         46,
+		Name:        "contains_metal",
+		Description: "Contains metal. Specific type of metal contained can be expressed by an other tag.",
+	},
+	{
+		Key:         47,
+		Name:        "contains_bronze",
+		Description: "Contains bronze.",
+	},
+	{
+		Key:         48,
+		Name:        "contains_iron",
+		Description: "Contains iron.",
+	},
+	{
+		Key:         49,
+		Name:        "contains_steel",
+		Description: "Contains steel.",
+	},
+	{
+		Key:         50,
+		Name:        "contains_silver",
+		Description: "Contains silver (useful for antibacterial properties).",
+	},
+	{
+		Key:         51,
+		Name:        "contains_copper",
+		Description: "Contains copper.",
+	},
+	{
+		Key:         52,
+		Name:        "contains_aluminium",
+		Description: "Contains aluminium.",
+	},
+	{
+		Key:         53,
+		Name:        "contains_brass",
+		Description: "Contains brass.",
+	},
+	{
+		Key:         54,
+		Name:        "contains_tungsten",
+		Description: "Contains Tungsten (useful for radiation shielding).",
+	},
+	{
+		Key:         55,
+		Name:        "imitates_wood",
+		Description: "Imitates wood.",
+	},
+	{
+		Key:         56,
+		Name:        "imitates_metal",
+		Description: "Imitates metal.",
+	},
+	{
+		Key:         57,
+		Name:        "imitates_marble",
+		Description: "Imitates marble.",
+	},
+	{
+		Key:         58,
+		Name:        "imitates_stone",
+		Description: "Imitates stone.",
+	},
+	{
+		Key:         59,
+		Name:        "lithophane",
+		Description: "Specifically designed for lithophaning.",
+	},
+	{
+		Key:         60,
+		Name:        "recycled",
+		Description: "Part of the material is recycled.",
+	},
+	{
+		Key:         69,
+		Name:        "limited_edition",
+		Description: "The material is a limited edition run.",
+	},
+}
+
 func (e Tag) String() string {
 	return TagMap[uint64(e)]
 }
@@ -372,7 +706,8 @@ func (e Tag) MarshalYAML() (any, error) {
 	if str, ok := TagMap[uint64(e)]; ok {
 		return str, nil
 	}
-	return nil, fmt.Errorf("unknown enumeration: %d", e)
+	// Values unknown to this build are kept as their number
+	return uint64(e), nil
 }
 
 func (e *Tag) UnmarshalYAML(value *yaml.Node) error {
@@ -380,13 +715,87 @@ func (e *Tag) UnmarshalYAML(value *yaml.Node) error {
 	if err := value.Decode(&str); err != nil {
 		return err
 	}
+	key, err := enumKeyFromName(TagMap, str)
+	if err != nil {
+		return err
+	}
+	*e = Tag(key)
+	return nil
+}
+
+func (e Tag) MarshalJSON() ([]byte, error) {
+	str, err := e.MarshalYAML()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(str)
+}
+
+func (e *Tag) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		// Values unknown to this build are exported as numbers
+		var key uint64
+		if json.Unmarshal(data, &key) != nil {
+			return err
+		}
+		*e = Tag(key)
+		return nil
+	}
+	key, err := enumKeyFromName(TagMap, str)
+	if err != nil {
+		return err
+	}
+	*e = Tag(key)
+	return nil
+}
+
+// Info returns the specification information for the value, false if the value is unknown
+func (e Tag) Info() (EnumInfo, bool) {
+	return lookupEnumInfo(tagInfo, uint64(e))
+}
+
+// DisplayName returns the specification name
+// Values unknown to this build are rendered as their number
+func (e Tag) DisplayName() string {
+	info, found := e.Info()
+	if !found {
+		return fmt.Sprintf("%d", e)
+	}
+	return info.Name
+}
+
+// Description returns the specification description
+func (e Tag) Description() string {
+	info, _ := e.Info()
+	return info.Description
+}
+
+// Abbreviation returns the abbreviation, if any
+func (e Tag) Abbreviation() string {
+	info, _ := e.Info()
+	return info.Abbreviation
+}
+
+// IsDeprecated returns true if the specification has deprecated the value
+func (e Tag) IsDeprecated() bool {
+	info, _ := e.Info()
+	return info.Deprecated
+}
+
+// ParseTag parses a value from its name, abbreviation or numeric key, ignoring case
+func ParseTag(str string) (Tag, error) {
+	key, err := parseEnum(tagInfo, str)
+	return Tag(key), err
+}
 
-	// Hardly efficient, but this is not critical here
-	for key, name := range TagMap {
-		if name == str {
-			*e = Tag(key)
-			return nil
+// AllTag returns all values that are not deprecated, in specification order
+func AllTag() []Tag {
+	values := make([]Tag, 0, len(tagInfo))
+	for _, info := range tagInfo {
+		if !info.Deprecated {
+			values = append(values, Tag(info.Key))
 		}
 	}
-	return fmt.Errorf("unknown enumeration: %s", str)
+	return values
 }
@@ -0,0 +1,73 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	a, err := openprinttag.FromYAML(dataToFill)
+	require.NoError(err)
+	b, err := openprinttag.FromYAML(dataToFill)
+	require.NoError(err)
+
+	assert.True(openprinttag.Equal(a, b))
+	assert.True(openprinttag.Diff(a, b).IsEmpty())
+
+	b.MainRegion().
+		SetBrandName("Another Brand").
+		ClearPreheatTemperature().
+		SetMaterialAbbreviation("PLA")
+	b.AuxRegion().
+		SetConsumedWeight(12).
+		SetVendorSpecificField(65530, "vendor")
+
+	diff := openprinttag.Diff(a, b)
+	assert.False(openprinttag.Equal(a, b))
+	assert.Nil(diff.Meta)
+
+	require.NotNil(diff.Main)
+	require.Len(diff.Main.Changed, 1)
+	assert.Equal("brand_name", diff.Main.Changed[0].Name)
+	assert.Equal(11, diff.Main.Changed[0].Key)
+	assert.Equal("Prusament", diff.Main.Changed[0].From)
+	assert.Equal("Another Brand", diff.Main.Changed[0].To)
+	require.Len(diff.Main.Removed, 1)
+	assert.Equal("preheat_temperature", diff.Main.Removed[0].Name)
+	require.Len(diff.Main.Added, 1)
+	assert.Equal("material_abbreviation", diff.Main.Added[0].Name)
+
+	require.NotNil(diff.Aux)
+	require.Len(diff.Aux.Added, 2)
+	assert.Equal("consumed_weight", diff.Aux.Added[0].Name)
+	assert.True(diff.Aux.Added[1].Unknown)
+	assert.Equal(uint64(65530), diff.Aux.Added[1].Key)
+	assert.Equal("vendor", diff.Aux.Added[1].To)
+}
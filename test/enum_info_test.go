@@ -0,0 +1,90 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumMetadata(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("PLA", openprinttag.MaterialTypePLA.Abbreviation())
+	assert.Equal("Polylactic Acid", openprinttag.MaterialTypePLA.DisplayName())
+	assert.Contains(openprinttag.MaterialTypePLA.Description(), "biodegradable")
+	assert.False(openprinttag.MaterialTypePLA.IsDeprecated())
+
+	assert.Equal("abrasive", openprinttag.TagAbrasive.DisplayName())
+	assert.Equal("", openprinttag.TagAbrasive.Abbreviation())
+
+	info, found := openprinttag.WriteProtectionIrreversible.Info()
+	assert.True(found)
+	assert.Equal(uint64(1), info.Key)
+	assert.Equal("irreversible", info.Name)
+
+	_, found = openprinttag.MaterialType(9999).Info()
+	assert.False(found)
+	assert.Equal("9999", openprinttag.MaterialType(9999).DisplayName())
+}
+
+func TestEnumParse(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	for _, input := range []string{"PETG", "petg", "Polyethylene Terephthalate Glycol", "polyethylene terephthalate glycol", "1", " PETG "} {
+		value, err := openprinttag.ParseMaterialType(input)
+		require.NoError(err, input)
+		assert.Equal(openprinttag.MaterialTypePETG, value, input)
+	}
+
+	tag, err := openprinttag.ParseTag("Glow_In_The_Dark")
+	require.NoError(err)
+	assert.Equal(openprinttag.TagGlowInTheDark, tag)
+
+	class, err := openprinttag.ParseMaterialClass("sla")
+	require.NoError(err)
+	assert.Equal(openprinttag.MaterialClassSLA, class)
+
+	_, err = openprinttag.ParseMaterialType("unobtainium")
+	assert.EqualError(err, "unknown enumeration: unobtainium")
+	_, err = openprinttag.ParseMaterialClass("7")
+	assert.EqualError(err, "unknown enumeration: 7")
+}
+
+func TestEnumAll(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]openprinttag.MaterialClass{openprinttag.MaterialClassFFF, openprinttag.MaterialClassSLA}, openprinttag.AllMaterialClass())
+	assert.Len(openprinttag.AllTag(), len(openprinttag.TagMap))
+	assert.Len(openprinttag.AllMaterialType(), len(openprinttag.MaterialTypeMap))
+	assert.Len(openprinttag.AllMaterialCertifications(), len(openprinttag.MaterialCertificationsMap))
+	assert.Len(openprinttag.AllWriteProtection(), len(openprinttag.WriteProtectionMap))
+
+	// Specification order, not key order
+	tags := openprinttag.AllTag()
+	assert.Equal(openprinttag.TagFiltrationRecommended, tags[0])
+	assert.Equal(openprinttag.TagHomeCompostable, tags[2])
+}
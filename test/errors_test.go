@@ -0,0 +1,112 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDecodeErrors checks that structural decode failures report the reason, layer and offset
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		sentinel error
+		layer    openprinttag.ErrorLayer
+		offset   int
+	}{
+		{"bad magic", []byte{0x00, 0x40, 0x02, 0x01, 0x03, 0x00, 0xFE, 0x00}, openprinttag.ErrInvalidCapabilityContainer, openprinttag.LayerCC, 0},
+		{"no ndef tlv", []byte{0xE1, 0x40, 0x02, 0x01, 0x01, 0x01, 0x00, 0xFE, 0x00}, openprinttag.ErrNoNDEFTLV, openprinttag.LayerTLV, 7},
+		{"truncated cc", []byte{0xE1, 0x40}, openprinttag.ErrTruncated, openprinttag.LayerCC, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := openprinttag.Decode(test.data)
+			require.Error(t, err)
+			assert.ErrorIs(t, err, test.sentinel)
+
+			var decodeErr *openprinttag.DecodeError
+			require.ErrorAs(t, err, &decodeErr)
+			assert.Equal(t, test.layer, decodeErr.Layer)
+			assert.Equal(t, test.offset, decodeErr.Offset)
+		})
+	}
+}
+
+// TestDecodeRegionErrorOffset corrupts the main region of an otherwise valid tag and
+// ensures the error points at the start of the main region
+func TestDecodeRegionErrorOffset(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag, err := openprinttag.FromYAML(dataToFill)
+	require.NoError(err)
+	tag.WithSize(304).WithAuxRegionSize(32)
+	data, err := tag.Encode()
+	require.NoError(err)
+	stats, ok := tag.GetStats()
+	require.True(ok)
+
+	// A break code with no indefinite container to close
+	data[stats.Main.AbsoluteOffset] = 0xFF
+
+	_, err = openprinttag.Decode(data)
+	require.Error(err)
+	assert.ErrorIs(err, openprinttag.ErrInvalidRegion)
+
+	var decodeErr *openprinttag.DecodeError
+	require.ErrorAs(err, &decodeErr)
+	assert.Equal(openprinttag.LayerMain, decodeErr.Layer)
+	assert.Equal(openprinttag.ReasonInvalidRegion, decodeErr.Reason)
+	assert.Equal(stats.Main.AbsoluteOffset, decodeErr.Offset)
+	assert.NotNil(decodeErr.Err)
+}
+
+// TestEncodeErrors checks that encode failures can be identified by sentinel and type
+func TestEncodeErrors(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag := openprinttag.NewOpenPrintTag().
+		WithAuxRegionSize(32).
+		WithSize(304)
+	tag.AuxRegion().SetVendorSpecificField(655300, make([]byte, 1024))
+
+	_, err := tag.Encode()
+	require.Error(err)
+	assert.ErrorIs(err, openprinttag.ErrRegionTooLarge)
+	var encodeErr *openprinttag.EncodeError
+	require.True(errors.As(err, &encodeErr))
+	assert.Equal(openprinttag.LayerAux, encodeErr.Layer)
+
+	_, err = openprinttag.NewOpenPrintTag().WithSize(300).Encode()
+	assert.ErrorIs(err, openprinttag.ErrInvalidTagSize)
+
+	_, err = openprinttag.NewOpenPrintTag().WithSize(24).Encode()
+	assert.ErrorIs(err, openprinttag.ErrInsufficientSpace)
+}
@@ -0,0 +1,73 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExtendedCapabilityContainer ensures tags beyond 2040 bytes are encoded with the
+// 8 byte CC, decode correctly and report the larger overhead
+func TestExtendedCapabilityContainer(t *testing.T) {
+	tests := []struct {
+		size int
+		cc   []byte
+	}{
+		{2040, []byte{0xE1, 0x40, 0xFF, 0x01}},
+		{2048, []byte{0xE2, 0x40, 0x00, 0x01, 0x00, 0x00, 0x00, 0xFF}},
+		{8192, []byte{0xE2, 0x40, 0x00, 0x01, 0x00, 0x00, 0x03, 0xFF}},
+	}
+
+	for _, test := range tests {
+		require := require.New(t)
+		assert := assert.New(t)
+
+		tag, err := openprinttag.FromYAML(dataToFill)
+		require.NoError(err)
+		tag.WithSize(test.size).WithAuxRegionSize(64)
+		data, err := tag.Encode()
+		require.NoError(err)
+		assert.Equal(test.cc, data[:len(test.cc)])
+
+		stats, ok := tag.GetStats()
+		require.True(ok)
+		assert.Equal(len(test.cc), stats.Root.CapabilityContainerSize)
+		assert.Equal(1+3+1, stats.Root.TLVOverhead, "NDEF TLV with 3 byte length and terminator")
+		assert.Equal(stats.Root.Overhead, stats.Root.CapabilityContainerSize+stats.Root.TLVOverhead+stats.Root.NDEFOverhead)
+
+		decoded, err := openprinttag.Decode(data)
+		require.NoError(err)
+		assert.Empty(openprinttag.Diff(tag, decoded).Meta)
+		reencoded, err := decoded.Encode()
+		require.NoError(err)
+		assert.Equal(data, reencoded)
+
+		// Without the CC, the whole CC is removed
+		noCC, err := tag.Encode(openprinttag.WithoutCapabilityContainer)
+		require.NoError(err)
+		assert.Equal(data[len(test.cc):], noCC)
+	}
+}
@@ -0,0 +1,123 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldInfo(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	assert.Len(openprinttag.MetaFields(), 4)
+	assert.Len(openprinttag.AuxFields(), 4)
+
+	info, found := openprinttag.LookupField("main", "material_class")
+	require.True(found)
+	assert.Equal(8, info.Key)
+	assert.Equal(openprinttag.FieldTypeEnum, info.Type)
+	assert.Equal("MaterialClass", info.Enum)
+	assert.Equal(openprinttag.FieldRequired, info.Requirement)
+	assert.Equal("required", info.Requirement.String())
+
+	info, found = openprinttag.LookupField("main", "brand_name")
+	require.True(found)
+	assert.Equal(openprinttag.FieldRecommended, info.Requirement)
+	assert.Equal(31, info.MaxLength)
+	assert.NotEmpty(info.Description)
+
+	info, found = openprinttag.LookupField("aux", "consumed_weight")
+	require.True(found)
+	assert.Equal("g", info.Unit)
+	assert.Equal(openprinttag.FieldTypeNumber, info.Type)
+
+	_, found = openprinttag.LookupField("main", "brand_nmae")
+	assert.False(found)
+	_, found = openprinttag.LookupField("other", "brand_name")
+	assert.False(found)
+
+	// Callers get their own copy
+	fields := openprinttag.MainFields()
+	fields[0].Name = "changed"
+	assert.NotEqual("changed", openprinttag.MainFields()[0].Name)
+}
+
+// TestFieldInfoMatchesSchema ensures the registry and the generated schema agree
+func TestFieldInfoMatchesSchema(t *testing.T) {
+	assert := assert.New(t)
+
+	regions := loadSchema(t).Properties["data"].Properties
+	check := func(region string, fields []openprinttag.FieldInfo) {
+		// Schema properties are all fields plus "other"
+		count := 1
+		for _, field := range fields {
+			count++
+			node := regions[region].Properties[field.Name]
+			if !assert.NotNil(node, "%s.%s", region, field.Name) {
+				continue
+			}
+			assert.Equal(field.Key, *node.Key, field.Name)
+			assert.Equal(field.Unit, node.Unit, field.Name)
+			assert.Equal(field.Requirement == openprinttag.FieldRequired, node.Required, field.Name)
+			assert.Equal(field.Requirement == openprinttag.FieldRecommended, node.Recommended, field.Name)
+			assert.Equal(field.Deprecated, node.Deprecated, field.Name)
+		}
+		assert.Len(regions[region].Properties, count, region)
+	}
+	check("meta", openprinttag.MetaFields())
+	check("main", openprinttag.MainFields())
+	check("aux", openprinttag.AuxFields())
+}
+
+// TestFieldDeprecation ensures replacements are only given for deprecated fields, and
+// name a field that exists
+func TestFieldDeprecation(t *testing.T) {
+	assert := assert.New(t)
+
+	for region, fields := range map[string][]openprinttag.FieldInfo{
+		"meta": openprinttag.MetaFields(),
+		"main": openprinttag.MainFields(),
+		"aux":  openprinttag.AuxFields(),
+	} {
+		for _, field := range fields {
+			if field.ReplacedBy == "" {
+				continue
+			}
+			assert.True(field.Deprecated, "%s.%s", region, field.Name)
+			_, found := openprinttag.LookupField(region, field.ReplacedBy)
+			assert.True(found, "%s.%s replaced by %s", region, field.Name, field.ReplacedBy)
+		}
+	}
+
+	// The example data uses no deprecated fields or values
+	tag, err := openprinttag.FromYAML(dataToFill)
+	assert.NoError(err)
+	warnings := tag.OptCheck().Messages(openprinttag.SeverityWarning)
+	for _, warning := range warnings {
+		assert.NotContains(warning, "deprecated")
+	}
+}
@@ -0,0 +1,139 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFilamentTag returns a 1kg PLA spool with 250g consumed
+func newFilamentTag() *openprinttag.OpenPrintTag {
+	tag := openprinttag.NewOpenPrintTag().WithSize(304).WithAuxRegionSize(32)
+	tag.MainRegion().
+		SetMaterialClass(openprinttag.MaterialClassFFF).
+		SetNominalNettoFullWeight(1000).
+		SetNominalFullLength(330000).
+		SetEmptyContainerWeight(200).
+		SetDensity(1.24).
+		SetFilamentDiameter(1.75)
+	tag.AuxRegion().SetConsumedWeight(250)
+	return tag
+}
+
+func TestFilamentRemaining(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag := newFilamentTag()
+
+	remaining, found := tag.RemainingWeight()
+	require.True(found)
+	assert.Equal(750.0, remaining)
+
+	percent, found := tag.RemainingPercent()
+	require.True(found)
+	assert.Equal(75.0, percent)
+
+	// 750g at 1.24 g/cm³ and 1.75mm diameter
+	length, found := tag.RemainingLength()
+	require.True(found)
+	assert.InDelta(251463, length, 1)
+
+	gross, found := tag.GrossWeight()
+	require.True(found)
+	assert.Equal(950.0, gross)
+
+	// The actual weight takes precedence over the nominal weight
+	tag.MainRegion().SetActualNettoFullWeight(1010)
+	remaining, _ = tag.RemainingWeight()
+	assert.Equal(760.0, remaining)
+
+	// Without density the length is proportional to the full length
+	tag.MainRegion().ClearDensity().ClearActualNettoFullWeight()
+	length, found = tag.RemainingLength()
+	require.True(found)
+	assert.InDelta(247500, length, 0.001)
+
+	// Nothing can be derived from an empty tag
+	empty := openprinttag.NewOpenPrintTag()
+	_, found = empty.RemainingWeight()
+	assert.False(found)
+	_, found = empty.RemainingLength()
+	assert.False(found)
+	_, found = empty.GrossWeight()
+	assert.False(found)
+}
+
+func TestConsumedWeightFromGross(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag := newFilamentTag()
+	consumed, err := tag.ConsumedWeightFromGross(812.5)
+	require.NoError(err)
+	assert.Equal(387.5, consumed)
+
+	// Scale readings outside of the possible range are limited
+	consumed, err = tag.ConsumedWeightFromGross(1250)
+	require.NoError(err)
+	assert.Equal(0.0, consumed)
+	consumed, err = tag.ConsumedWeightFromGross(150)
+	require.NoError(err)
+	assert.Equal(1000.0, consumed)
+
+	tag.MainRegion().ClearEmptyContainerWeight()
+	_, err = tag.ConsumedWeightFromGross(812.5)
+	assert.EqualError(err, "empty_container_weight is required to calculate consumed weight")
+}
+
+func TestComputedYAML(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag := newFilamentTag()
+	computed := tag.ComputeValues()
+	assert.Empty(computed.Warnings)
+
+	// A length that does not match the weight is flagged
+	tag.MainRegion().SetNominalFullLength(300000)
+	computed = tag.ComputeValues()
+	assert.Equal([]string{"nominal_full_length of 300000 mm disagrees with 335284 mm expected from nominal_netto_full_weight, density and filament_diameter"}, computed.Warnings)
+
+	yamlData, err := tag.ToYAML(openprinttag.IncludeComputed)
+	require.NoError(err)
+	recordTestOutput(t, "yaml", []byte(yamlData))
+	assert.Contains(yamlData, "computed:\n    remaining_weight: 750\n    remaining_length: 251462.71\n")
+	assert.Contains(yamlData, "remaining_percent: 75\n    gross_weight: 950\n")
+
+	// Computed values are for reading only
+	reconstituted, err := openprinttag.FromYAML(yamlData)
+	require.NoError(err)
+	assert.True(openprinttag.Equal(tag, reconstituted))
+
+	yamlData, err = tag.ToYAML()
+	require.NoError(err)
+	assert.NotContains(yamlData, "computed")
+}
@@ -0,0 +1,156 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fitTestTag is the example data, with a derivable brand UUID and a weight carrying an unneeded fraction
+func fitTestTag(t *testing.T) *openprinttag.OpenPrintTag {
+	tag, err := openprinttag.FromYAML(dataToFill)
+	require.NoError(t, err)
+	tag.MainRegion().
+		SetBrandUuid(openprinttag.BrandUUID("Prusament")).
+		SetActualNettoFullWeight(1012.4)
+	return tag
+}
+
+var fitOptions = openprinttag.FitOptions{
+	FloatTolerance:     0.01,
+	DropDerivableUUIDs: true,
+	DropPriority:       []string{"container_width", "max_chamber_temperature", "chamber_temperature"},
+}
+
+// TestEncodeToFit degrades a tag that is too large, checking each step is reported and applied
+func TestEncodeToFit(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag := fitTestTag(t).WithSize(152)
+	_, err := tag.Encode()
+	require.ErrorIs(err, openprinttag.ErrInsufficientSpace)
+
+	data, report, err := tag.EncodeToFit(fitOptions)
+	require.NoError(err)
+	require.NotNil(report)
+
+	expected := []openprinttag.FitChange{
+		{Region: "main", Field: "actual_netto_full_weight", Action: openprinttag.FitActionReducedPrecision, From: 1012.4, To: 1012.0},
+		{Region: "main", Field: "transmission_distance", Action: openprinttag.FitActionReducedPrecision, From: 0.2, To: 0.199951171875},
+		{Region: "main", Field: "brand_uuid", Action: openprinttag.FitActionDroppedUUID, From: openprinttag.BrandUUID("Prusament").String()},
+		{Region: "main", Field: "container_width", Action: openprinttag.FitActionDroppedField, From: 75},
+		{Region: "main", Field: "max_chamber_temperature", Action: openprinttag.FitActionDroppedField, From: 40},
+	}
+	assert.Equal(expected, report.Changes)
+
+	decoded, err := openprinttag.Decode(data)
+	require.NoError(err)
+	_, found := decoded.MainRegion().GetBrandUuid()
+	assert.False(found)
+	_, found = decoded.MainRegion().GetContainerWidth()
+	assert.False(found)
+	_, found = decoded.MainRegion().GetChamberTemperature()
+	assert.True(found, "not dropped as the data already fits")
+	weight, _ := decoded.MainRegion().GetActualNettoFullWeight()
+	assert.Equal(1012.0, weight)
+
+	// A tag that already fits is not changed
+	_, report, err = fitTestTag(t).WithSize(304).EncodeToFit(fitOptions)
+	require.NoError(err)
+	assert.Empty(report.Changes)
+}
+
+// TestEncodeToFitFailure ensures the tag is unchanged where it cannot be made to fit
+func TestEncodeToFitFailure(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag := fitTestTag(t).WithSize(120)
+	original := fitTestTag(t)
+	_, report, err := tag.EncodeToFit(fitOptions)
+	require.ErrorIs(err, openprinttag.ErrInsufficientSpace)
+	assert.Nil(report)
+	assert.True(openprinttag.Equal(original, tag))
+
+	_, _, err = tag.EncodeToFit(openprinttag.FitOptions{DropPriority: []string{"material_class"}})
+	assert.ErrorContains(err, "required")
+	_, _, err = tag.EncodeToFit(openprinttag.FitOptions{DropPriority: []string{"aux.no_such_field"}})
+	assert.ErrorContains(err, "unknown field")
+}
+
+// TestEncodeToFitFailureEncoding ensures a failed fit leaves the encoded form of a decoded tag unchanged
+func TestEncodeToFitFailureEncoding(t *testing.T) {
+	require := require.New(t)
+
+	image, err := fitTestTag(t).WithSize(304).WithAuxRegionSize(32).Encode()
+	require.NoError(err)
+	tag, err := openprinttag.Decode(image)
+	require.NoError(err)
+	before, err := tag.Encode()
+	require.NoError(err)
+
+	tag.WithSize(120)
+	_, _, err = tag.EncodeToFit(fitOptions)
+	require.ErrorIs(err, openprinttag.ErrInsufficientSpace)
+
+	after, err := tag.WithSize(304).Encode()
+	require.NoError(err)
+	require.Equal(before, after)
+}
+
+// TestEncodeToFitDecodedAuxOffset ensures the aux region offset of a decoded tag is
+// placed afresh when the tag no longer fits with it
+func TestEncodeToFitDecodedAuxOffset(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	image, err := fitTestTag(t).WithSize(304).WithAuxRegionSize(32).Encode()
+	require.NoError(err)
+	tag, err := openprinttag.Decode(image)
+	require.NoError(err)
+	oldOffset, found := tag.MetaRegion().GetAuxRegionOffset()
+	require.True(found)
+
+	// The data fits on the smaller tag, but not with the aux region where it was
+	tag.WithSize(240).WithAuxRegionSize(32)
+	_, err = tag.Encode()
+	require.Error(err)
+
+	tag, err = openprinttag.Decode(image)
+	require.NoError(err)
+	tag.WithSize(240).WithAuxRegionSize(32)
+	data, report, err := tag.EncodeToFit(openprinttag.FitOptions{})
+	require.NoError(err)
+	assert.Empty(report.Changes)
+	newOffset, found := tag.MetaRegion().GetAuxRegionOffset()
+	require.True(found)
+	assert.Less(newOffset, oldOffset)
+
+	decoded, err := openprinttag.Decode(data)
+	require.NoError(err)
+	assert.True(openprinttag.Equal(tag, decoded))
+}
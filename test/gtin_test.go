@@ -0,0 +1,136 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGTINCheckDigit(t *testing.T) {
+	assert := assert.New(t)
+
+	digit, err := openprinttag.GTINCheckDigit("400638133393")
+	assert.NoError(err)
+	assert.Equal(1, digit)
+
+	digit, err = openprinttag.GTINCheckDigit("9638507")
+	assert.NoError(err)
+	assert.Equal(4, digit)
+
+	_, err = openprinttag.GTINCheckDigit("40063813339x")
+	assert.Error(err)
+}
+
+func TestParseGTIN(t *testing.T) {
+	for _, test := range []struct {
+		input    string
+		expected uint64
+		err      string
+	}{
+		{input: "4006381333931", expected: 4006381333931},
+		{input: "400-6381 333931", expected: 4006381333931},
+		{input: "96385074", expected: 96385074},
+		{input: "036000291452", expected: 36000291452},
+		{input: "10036000291459", expected: 10036000291459},
+		{input: "4006381333932", err: "GTIN 4006381333932 has check digit 2, expected 1"},
+		{input: "40063813339", err: `GTIN "40063813339" must have 8, 12, 13 or 14 digits`},
+		{input: "400638133393x", err: `GTIN "400638133393x" contains a non digit`},
+	} {
+		t.Run(test.input, func(t *testing.T) {
+			gtin, err := openprinttag.ParseGTIN(test.input)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, gtin)
+			assert.NoError(t, openprinttag.CheckGTIN(gtin))
+		})
+	}
+}
+
+func TestCheckAndFormatGTIN(t *testing.T) {
+	assert := assert.New(t)
+
+	// Leading zeros are not retained in the gtin field
+	assert.NoError(openprinttag.CheckGTIN(36000291452))
+	assert.Equal("0036000291452", openprinttag.FormatGTIN(36000291452))
+	assert.Equal("0000096385074", openprinttag.FormatGTIN(96385074))
+	assert.Equal("10036000291459", openprinttag.FormatGTIN(10036000291459))
+
+	assert.EqualError(openprinttag.CheckGTIN(0), "GTIN must not be zero")
+	assert.EqualError(openprinttag.CheckGTIN(123456789012345), "GTIN 123456789012345 has more than 14 digits")
+	assert.Error(openprinttag.CheckGTIN(4006381333932))
+}
+
+func TestGTINOptCheck(t *testing.T) {
+	assert := assert.New(t)
+
+	const gtin = 4006381333931
+	brand := openprinttag.BrandUUID("Acme")
+	derived := openprinttag.MaterialPackageUUID(strconv.FormatUint(gtin, 10), brand)
+
+	// An invalid check digit is an error
+	tag := openprinttag.NewOpenPrintTag()
+	tag.MainRegion().SetBrandName("Acme").SetGtin(gtin + 1)
+	errors := tag.OptCheck().Messages(openprinttag.SeverityError)
+	assert.Contains(errors, "gtin is invalid: GTIN 4006381333932 has check digit 2, expected 1")
+
+	// and no package UUID is derived from it
+	warnings := tag.OptCheck().Messages(openprinttag.SeverityWarning)
+	assert.Contains(warnings, "failed to deduce package_uuid, either package_uuid or gtin and brand_name (or brand_uuid) are required")
+	y, err := tag.ToYAML(openprinttag.IncludeUUIDs)
+	assert.NoError(err)
+	assert.Contains(y, "package_uuid: null")
+
+	// A package UUID matching the derived one can be omitted
+	tag = openprinttag.NewOpenPrintTag()
+	tag.MainRegion().SetBrandName("Acme").SetGtin(gtin).SetPackageUuid(derived)
+	report := tag.OptCheck()
+	errors, warnings = report.Messages(openprinttag.SeverityError), report.Messages(openprinttag.SeverityWarning)
+	assert.Empty(errors)
+	assert.Contains(warnings, "package_uuid is identical to the auto-generated version, and thus can be omitted to save space")
+
+	// Neither a GTIN nor a package UUID
+	tag = openprinttag.NewOpenPrintTag()
+	tag.MainRegion().SetBrandName("Acme")
+	warnings = tag.OptCheck().Messages(openprinttag.SeverityWarning)
+	assert.Contains(warnings, "failed to deduce package_uuid, either package_uuid or gtin and brand_name (or brand_uuid) are required")
+
+	// A GTIN without a brand
+	tag = openprinttag.NewOpenPrintTag()
+	tag.MainRegion().SetGtin(gtin)
+	warnings = tag.OptCheck().Messages(openprinttag.SeverityWarning)
+	assert.Contains(warnings, "failed to deduce package_uuid, either package_uuid or gtin and brand_name (or brand_uuid) are required")
+
+	// A GTIN and brand UUID is sufficient
+	tag = openprinttag.NewOpenPrintTag()
+	tag.MainRegion().SetBrandUuid(brand).SetGtin(gtin)
+	warnings = tag.OptCheck().Messages(openprinttag.SeverityWarning)
+	for _, warning := range warnings {
+		assert.NotContains(warning, "package_uuid")
+	}
+}
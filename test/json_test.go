@@ -0,0 +1,97 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag, err := openprinttag.FromYAML(dataToFill)
+	require.NoError(err)
+	tag.WithSize(304).WithAuxRegionSize(32)
+	tag.AuxRegion().SetVendorSpecificField(65530, "vendor")
+	tag.WithURIRecord("https://example.com/spool")
+
+	jsonData, err := tag.ToJSON(openprinttag.IncludeAll)
+	require.NoError(err)
+	recordTestOutput(t, "json", []byte(jsonData))
+
+	// Same document structure as YAML, with values rendered the same way
+	var doc map[string]any
+	require.NoError(json.Unmarshal([]byte(jsonData), &doc))
+	for _, section := range []string{"data", "validate", "opt_check", "uuids", "regions", "root", "uri"} {
+		assert.Contains(doc, section)
+	}
+	main := doc["data"].(map[string]any)["main"].(map[string]any)
+	assert.Equal("FFF", main["material_class"])
+	assert.Equal("PLA", main["material_type"])
+	assert.Equal("#3d3e3d", main["primary_color"])
+	aux := doc["data"].(map[string]any)["aux"].(map[string]any)
+	assert.Equal("vendor", aux["other"].(map[string]any)["65530"])
+
+	reconstituted, err := openprinttag.FromJSON(jsonData)
+	require.NoError(err)
+	assert.True(openprinttag.Diff(tag, reconstituted).IsEmpty())
+	assert.Equal("vendor", reconstituted.AuxRegion().GetVendorSpecificField(65530))
+	records := reconstituted.Records()
+	require.Len(records, 1)
+	uri, isURI := records[0].URI()
+	assert.True(isURI)
+	assert.Equal("https://example.com/spool", uri)
+}
+
+func TestJSONMatchesYAML(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	fromYAML, err := openprinttag.FromYAML(dataToFill)
+	require.NoError(err)
+	jsonData, err := fromYAML.ToJSON()
+	require.NoError(err)
+	fromJSON, err := openprinttag.FromJSON(jsonData)
+	require.NoError(err)
+
+	yamlA, err := fromYAML.ToYAML(openprinttag.IncludeUUIDs)
+	require.NoError(err)
+	yamlB, err := fromJSON.ToYAML(openprinttag.IncludeUUIDs)
+	require.NoError(err)
+	assert.Equal(yamlA, yamlB)
+}
+
+func TestJSONInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := openprinttag.FromJSON(`{"data": {"main": {"material_class": "NOPE"}}}`)
+	assert.ErrorContains(err, "unknown enumeration: NOPE")
+
+	_, err = openprinttag.FromJSON(`{"data": {"main": {"primary_color": "#zz"}}}`)
+	assert.ErrorContains(err, "invalid color_rgba")
+}
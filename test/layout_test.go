@@ -0,0 +1,162 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// thirdPartyTag builds a 128 byte tag image in a form that this library would never
+// produce itself; definite maps, keys out of order, unknown keys interleaved with known
+// keys, floats wider than necessary, a lock control TLV, non standard CC and garbage in
+// unused payload space
+func thirdPartyTag() []byte {
+	f32 := func(v float32) []byte {
+		return binary.BigEndian.AppendUint32([]byte{0xfa}, math.Float32bits(v))
+	}
+
+	// main: {11: "Brand", 65000: "x", 8: 0, 16: 1000.0f32, 29: 1.25f16}
+	main := []byte{0xa5, 0x0b, 0x65, 'B', 'r', 'a', 'n', 'd', 0x19, 0xfd, 0xe8, 0x61, 'x', 0x08, 0x00, 0x10}
+	main = append(main, f32(1000)...)
+	main = append(main, 0x18, 0x1d, 0xf9, 0x3d, 0x00)
+	// aux: indefinite {0: 12.5f32}
+	aux := append([]byte{0xbf, 0x00}, f32(12.5)...)
+	aux = append(aux, 0xff)
+	return thirdPartyImage(main, aux)
+}
+
+// thirdPartyImage builds a 128 byte tag image holding the given encoded main and
+// aux regions, with the aux region at payload offset 64
+func thirdPartyImage(main, aux []byte) []byte {
+	payload := bytes.Repeat([]byte{0xaa}, 85)
+	auxOffset := 64
+
+	// meta: {2: 64}
+	meta := []byte{0xa1, 0x02, 0x18, byte(auxOffset)}
+
+	copy(payload, meta)
+	copy(payload[len(meta):], main)
+	copy(payload[auxOffset:], aux)
+
+	mime := "application/vnd.openprinttag"
+	record := []byte{0xd2, byte(len(mime)), byte(len(payload))}
+	record = append(record, mime...)
+	record = append(record, payload...)
+
+	image := []byte{0xe1, 0x40, 128 / 8, 0x00}          // CC, without MBREAD
+	image = append(image, 0x01, 0x03, 0xa0, 0x10, 0x44) // Lock control TLV
+	image = append(image, 0x03, byte(len(record)))
+	image = append(image, record...)
+	image = append(image, 0xfe)
+	return image
+}
+
+// TestByteIdenticalRoundTrip ensures that a decoded tag which is not modified is
+// re-encoded identically
+func TestByteIdenticalRoundTrip(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	original := thirdPartyTag()
+	require.Len(original, 128)
+
+	tag, err := openprinttag.Decode(original)
+	require.NoError(err)
+	assert.Equal(openprinttag.CBORContainerTypeDefinite, tag.MainRegion().RegionOptions().GetCBORContainerType())
+	assert.Equal(openprinttag.CBORContainerTypeIndefinite, tag.AuxRegion().RegionOptions().GetCBORContainerType())
+
+	encoded, err := tag.Encode()
+	require.NoError(err)
+	assert.Equal(original, encoded)
+
+	// Changing a field keeps the remaining layout intact
+	tag.AuxRegion().SetConsumedWeight(20.25)
+	patches, err := tag.EncodeAuxPatch(original)
+	require.NoError(err)
+	require.Len(patches, 1)
+
+	// Dropping the layout results in a freshly laid out tag
+	tag.ClearDecodedLayout()
+	encoded, err = tag.Encode()
+	require.NoError(err)
+	assert.NotEqual(original, encoded)
+	redecoded, err := openprinttag.Decode(encoded)
+	require.NoError(err)
+	assert.True(openprinttag.Equal(tag, redecoded))
+}
+
+// TestArrayFormRoundTrip ensures that arrays encoded in a different form to the map
+// holding them are re-encoded in their original form
+func TestArrayFormRoundTrip(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	// main: indefinite {8: 0, 28: [17, 16], 65000: [1, 2]}
+	main := []byte{0xbf, 0x08, 0x00, 0x18, 0x1c, 0x82, 0x11, 0x10, 0x19, 0xfd, 0xe8, 0x82, 0x01, 0x02, 0xff}
+	// aux: {1000: indefinite [1]}
+	aux := []byte{0xa1, 0x19, 0x03, 0xe8, 0x9f, 0x01, 0xff}
+	original := thirdPartyImage(main, aux)
+
+	tag, err := openprinttag.Decode(original)
+	require.NoError(err)
+	tags, found := tag.MainRegion().GetTags()
+	require.True(found)
+	assert.Equal([]openprinttag.Tag{openprinttag.TagSilk, openprinttag.TagMatte}, tags)
+
+	encoded, err := tag.Encode()
+	require.NoError(err)
+	assert.Equal(original, encoded)
+
+	// Without the decoded layout, arrays follow the form of their map
+	tag.ClearDecodedLayout()
+	encoded, err = tag.Encode()
+	require.NoError(err)
+	assert.NotEqual(original, encoded)
+	redecoded, err := openprinttag.Decode(encoded)
+	require.NoError(err)
+	assert.True(openprinttag.Equal(tag, redecoded))
+}
+
+// TestLibraryRoundTrip ensures that tags produced by this library round trip identically
+func TestLibraryRoundTrip(t *testing.T) {
+	require := require.New(t)
+
+	tag, err := openprinttag.FromYAML(dataToFill)
+	require.NoError(err)
+	tag.WithSize(304).WithAuxRegionSize(32)
+	tag.AuxRegion().SetConsumedWeight(1.5).SetVendorSpecificField(1000, "a").SetVendorSpecificField(1001, "b")
+	original, err := tag.Encode()
+	require.NoError(err)
+
+	decoded, err := openprinttag.Decode(original)
+	require.NoError(err)
+	encoded, err := decoded.Encode()
+	require.NoError(err)
+	require.Equal(original, encoded)
+}
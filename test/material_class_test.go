@@ -0,0 +1,133 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// TestMaterialClassRulesFile ensures the rules name fields and values that exist, and that
+// every material type is assigned to a class, so that specification updates are not missed
+func TestMaterialClassRulesFile(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	data, err := os.ReadFile("../material_class_rules.yaml")
+	require.NoError(err)
+	var rules struct {
+		Classes map[string]struct {
+			Fields        []string `yaml:"fields"`
+			MaterialTypes []string `yaml:"material_types"`
+		} `yaml:"classes"`
+	}
+	require.NoError(yaml.Unmarshal(data, &rules))
+
+	assignedTypes := map[string]bool{}
+	for className, rule := range rules.Classes {
+		_, err := openprinttag.ParseMaterialClass(className)
+		assert.NoError(err, className)
+		for _, path := range rule.Fields {
+			_, found, err := openprinttag.NewOpenPrintTag().Get(path)
+			assert.NoError(err, path)
+			assert.False(found, path)
+		}
+		for _, name := range rule.MaterialTypes {
+			assert.False(assignedTypes[name], "%s assigned to more than one class", name)
+			assignedTypes[name] = true
+			assert.Contains(mapValues(openprinttag.MaterialTypeMap), name)
+		}
+	}
+	for _, name := range openprinttag.MaterialTypeMap {
+		assert.True(assignedTypes[name], "%s is not assigned to a material class", name)
+	}
+}
+
+func TestMaterialClassApplies(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(openprinttag.FieldApplies(openprinttag.MaterialClassFFF, "main", "filament_diameter"))
+	assert.False(openprinttag.FieldApplies(openprinttag.MaterialClassSLA, "main", "filament_diameter"))
+	assert.True(openprinttag.FieldApplies(openprinttag.MaterialClassSLA, "main", "cure_wavelength"))
+	assert.False(openprinttag.FieldApplies(openprinttag.MaterialClassFFF, "aux", "last_stir_time"))
+
+	// Fields not specific to a class apply to all
+	assert.True(openprinttag.FieldApplies(openprinttag.MaterialClassFFF, "main", "density"))
+	assert.True(openprinttag.FieldApplies(openprinttag.MaterialClassSLA, "main", "density"))
+
+	assert.True(openprinttag.MaterialTypeApplies(openprinttag.MaterialClassFFF, openprinttag.MaterialTypePLA))
+	assert.False(openprinttag.MaterialTypeApplies(openprinttag.MaterialClassSLA, openprinttag.MaterialTypePLA))
+}
+
+func TestMaterialClassWarnings(t *testing.T) {
+	assert := assert.New(t)
+
+	tag := openprinttag.NewOpenPrintTag()
+	tag.MainRegion().
+		SetMaterialClass(openprinttag.MaterialClassSLA).
+		SetBrandName("Acme").
+		SetMaterialName("Acme Resin").
+		SetGtin(4006381333931).
+		SetMaterialType(openprinttag.MaterialTypePETG).
+		SetFilamentDiameter(1.75).
+		SetCureWavelength(405)
+	warnings := tag.OptCheck().Messages(openprinttag.SeverityWarning)
+	assert.Equal([]string{
+		"material_type PETG is not applicable to material_class SLA",
+		"main.filament_diameter is not applicable to material_class SLA",
+	}, warnings)
+
+	// Both findings are reported against their field
+	report := tag.OptCheck()
+	typeIssues := report.WithCode(openprinttag.RuleMaterialTypeNotApplicable).Issues
+	if assert.Len(typeIssues, 1) {
+		assert.Equal("main", typeIssues[0].Region)
+		assert.Equal("material_type", typeIssues[0].Field)
+		assert.NotNil(typeIssues[0].Key)
+	}
+	fieldIssues := report.WithCode(openprinttag.RuleFieldNotApplicable).Issues
+	if assert.Len(fieldIssues, 1) {
+		assert.Equal("main", fieldIssues[0].Region)
+		assert.Equal("filament_diameter", fieldIssues[0].Field)
+		assert.NotNil(fieldIssues[0].Key)
+	}
+
+	// The example filament data is consistent
+	tag, err := openprinttag.FromYAML(dataToFill)
+	assert.NoError(err)
+	warnings = tag.OptCheck().Messages(openprinttag.SeverityWarning)
+	for _, warning := range warnings {
+		assert.NotContains(warning, "not applicable")
+	}
+
+	// Unknown material classes are not checked
+	tag.MainRegion().SetMaterialClass(openprinttag.MaterialClass(7)).SetCureWavelength(405)
+	warnings = tag.OptCheck().Messages(openprinttag.SeverityWarning)
+	for _, warning := range warnings {
+		assert.NotContains(warning, "not applicable")
+	}
+}
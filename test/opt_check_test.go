@@ -0,0 +1,211 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// TestOptCheck checks the errors, warnings and notes reported for a set of tags
+func TestOptCheck(t *testing.T) {
+	const (
+		brand    = "    brand_name: Prusament\n"
+		material = "    material_name: PLA Galaxy Black\n"
+		gtin     = "    gtin: 8594173675469\n"
+		aux      = "  aux: {}\n"
+		data     = "data:\n  main:\n    material_class: FFF\n"
+	)
+	validUID := []byte{0x0e, 0x04, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+
+	for _, test := range []struct {
+		name     string
+		yaml     string
+		uid      []byte
+		errors   []string
+		warnings []string
+		notes    []string
+	}{
+		{
+			name: "all uuids derived",
+			yaml: data + brand + material + gtin + aux,
+			notes: []string{
+				"brand_uuid derived from brand_name",
+				"material_uuid derived from material_name and brand",
+				"package_uuid derived from gtin and brand",
+				"instance_uuid will be derived from the NFC UID when the tag is read",
+			},
+		},
+		{
+			name: "uuids identical to derived",
+			yaml: data + brand + material + gtin +
+				"    brand_uuid: ae5ff34e-298e-50c9-8f77-92a97fb30b09\n" +
+				"    material_uuid: 6e774110-9aa4-5ab2-a269-456918dad9b1\n" +
+				"    package_uuid: 5d73a040-77c9-55ee-95bc-f4ef0fc2efda\n" + aux,
+			warnings: []string{
+				"brand_uuid is identical to the auto-generated version, and thus can be omitted to save space",
+				"material_uuid is identical to the auto-generated version, and thus can be omitted to save space",
+				"package_uuid is identical to the auto-generated version, and thus can be omitted to save space",
+			},
+			notes: []string{"instance_uuid will be derived from the NFC UID when the tag is read"},
+		},
+		{
+			name: "explicit uuids",
+			yaml: data +
+				"    brand_uuid: 00000000-0000-0000-0000-000000000001\n" +
+				"    material_uuid: 00000000-0000-0000-0000-000000000002\n" +
+				"    package_uuid: 00000000-0000-0000-0000-000000000003\n" +
+				"    instance_uuid: 00000000-0000-0000-0000-000000000004\n" + aux,
+		},
+		{
+			name: "nothing to derive from",
+			yaml: data,
+			warnings: []string{
+				"failed to deduce brand_uuid, either brand_uuid or brand_name is required",
+				"failed to deduce material_uuid, either material_uuid or material_name and brand_name (or brand_uuid) are required",
+				"failed to deduce package_uuid, either package_uuid or gtin and brand_name (or brand_uuid) are required",
+			},
+			notes: []string{
+				"aux region is not present, so usage cannot be recorded on the tag",
+				"instance_uuid will be derived from the NFC UID when the tag is read",
+			},
+		},
+		{
+			name: "instance uuid derived from uid",
+			yaml: data + brand + material + gtin + aux,
+			uid:  validUID,
+			notes: []string{
+				"brand_uuid derived from brand_name",
+				"material_uuid derived from material_name and brand",
+				"package_uuid derived from gtin and brand",
+				"instance_uuid derived from NFC UID",
+			},
+		},
+		{
+			name: "instance uuid identical to derived",
+			yaml: data + "    brand_uuid: 00000000-0000-0000-0000-000000000001\n" +
+				"    material_uuid: 00000000-0000-0000-0000-000000000002\n" +
+				"    package_uuid: 00000000-0000-0000-0000-000000000003\n" +
+				"    instance_uuid: 3e66dabe-3ea7-5a31-82d1-0e276fbcda17\n" + aux,
+			uid:      validUID,
+			warnings: []string{"instance_uuid is identical to the auto-generated version, and thus can be omitted to save space"},
+		},
+		{
+			name: "invalid uid",
+			yaml: data + "    brand_uuid: 00000000-0000-0000-0000-000000000001\n" +
+				"    material_uuid: 00000000-0000-0000-0000-000000000002\n" +
+				"    package_uuid: 00000000-0000-0000-0000-000000000003\n" + aux,
+			uid:      []byte{0xe0, 0x04, 0x01, 0x02},
+			warnings: []string{"failed to deduce instance_uuid from NFC UID: NFC Tag UID should be 8 bytes long"},
+		},
+		{
+			name:     "invalid gtin and implausible data",
+			yaml:     data + brand + material + "    gtin: 8594173675460\n    min_print_temperature: 230\n    max_print_temperature: 210\n" + aux,
+			errors:   []string{"gtin is invalid: GTIN 8594173675460 has check digit 0, expected 9", "min_print_temperature of 230 °C is above max_print_temperature of 210 °C"},
+			warnings: []string{"failed to deduce package_uuid, either package_uuid or gtin and brand_name (or brand_uuid) are required"},
+			notes: []string{
+				"brand_uuid derived from brand_name",
+				"material_uuid derived from material_name and brand",
+				"instance_uuid will be derived from the NFC UID when the tag is read",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			tag, err := openprinttag.FromYAML(test.yaml)
+			require.NoError(t, err)
+			if test.uid != nil {
+				tag.WithTagUID(test.uid)
+			}
+			report := tag.OptCheck()
+			errors, warnings, notes := report.Messages(openprinttag.SeverityError), report.Messages(openprinttag.SeverityWarning), report.Messages(openprinttag.SeverityNote)
+			assert.Equal(t, test.errors, errors)
+			assert.Equal(t, test.warnings, warnings)
+			assert.Equal(t, test.notes, notes)
+		})
+	}
+}
+
+func TestOptCheckYAMLNotes(t *testing.T) {
+	tag, err := openprinttag.FromYAML(dataToFill)
+	require.NoError(t, err)
+	tag.WithTagUID([]byte{0x0e, 0x04, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06})
+	tag.MainRegion().ClearInstanceUuid()
+
+	y, err := tag.ToYAML(openprinttag.IncludeOptCheck, openprinttag.IncludeUUIDs)
+	require.NoError(t, err)
+	assert.Contains(t, y, "instance_uuid: 3e66dabe-3ea7-5a31-82d1-0e276fbcda17")
+
+	// The opt_check section holds the structured issues
+	var document openprinttag.YamlEncoder
+	require.NoError(t, yaml.Unmarshal([]byte(y), &document))
+	require.NotNil(t, document.OptCheck)
+	assert.Empty(t, document.OptCheck.Errors)
+	key := 0
+	assert.Contains(t, document.OptCheck.Notes, openprinttag.Issue{
+		Severity: openprinttag.SeverityNote,
+		Code:     openprinttag.RuleUUIDDerived,
+		Region:   "main",
+		Field:    "instance_uuid",
+		Key:      &key,
+		Message:  "instance_uuid derived from NFC UID",
+	})
+	if assert.Len(t, document.OptCheck.Warnings, 1) {
+		assert.Equal(t, openprinttag.RuleUUIDNotDeducible, document.OptCheck.Warnings[0].Code)
+	}
+
+	j, err := tag.ToJSON(openprinttag.IncludeOptCheck)
+	require.NoError(t, err)
+	document = openprinttag.YamlEncoder{}
+	require.NoError(t, json.Unmarshal([]byte(j), &document))
+	require.NotNil(t, document.OptCheck)
+	assert.Equal(t, tag.OptCheck().WithSeverity(openprinttag.SeverityNote).Issues, document.OptCheck.Notes)
+}
+
+func TestOptCheckLegacySections(t *testing.T) {
+	// Output written before the sections held structured issues is still read
+	y := dataToFill + "validate:\n    warnings:\n        - field Gtin (gtin/4) is recommended\n    errors: []\n"
+	_, err := openprinttag.FromYAML(y)
+	require.NoError(t, err)
+
+	// with each message taking the severity of its section
+	y = dataToFill + "opt_check:\n    warnings:\n        - some warning\n    errors:\n        - some error\n    notes:\n        - some note\n"
+	var document openprinttag.YamlEncoder
+	require.NoError(t, yaml.Unmarshal([]byte(y), &document))
+	require.NotNil(t, document.OptCheck)
+	assert.Equal(t, []openprinttag.Issue{{Severity: openprinttag.SeverityWarning, Message: "some warning"}}, document.OptCheck.Warnings)
+	assert.Equal(t, []openprinttag.Issue{{Severity: openprinttag.SeverityError, Message: "some error"}}, document.OptCheck.Errors)
+	assert.Equal(t, []openprinttag.Issue{{Severity: openprinttag.SeverityNote, Message: "some note"}}, document.OptCheck.Notes)
+
+	j := `{"data": {"main": {"material_class": "FFF"}}, "validate": {"warnings": ["some warning"], "errors": ["some error"]}}`
+	_, err = openprinttag.FromJSON(j)
+	require.NoError(t, err)
+	document = openprinttag.YamlEncoder{}
+	require.NoError(t, json.Unmarshal([]byte(j), &document))
+	require.NotNil(t, document.Validate)
+	assert.Equal(t, openprinttag.SeverityWarning, document.Validate.Warnings[0].Severity)
+	assert.Equal(t, openprinttag.SeverityError, document.Validate.Errors[0].Severity)
+}
@@ -0,0 +1,106 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuxPatch updates the consumed weight on a decoded tag and ensures that
+// the resulting patch is block aligned, confined to the aux region and
+// produces the same image as a full re-encode
+func TestAuxPatch(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag, err := openprinttag.FromYAML(dataToFill)
+	require.NoError(err)
+	tag.WithSize(304).WithAuxRegionSize(32)
+	original, err := tag.Encode()
+	require.NoError(err)
+
+	decoded, err := openprinttag.Decode(original)
+	require.NoError(err)
+
+	// Nothing changed, nothing to write
+	patches, err := decoded.EncodeAuxPatch(original)
+	require.NoError(err)
+	assert.Empty(patches)
+
+	decoded.WithBlockSize(8)
+	decoded.AuxRegion().SetConsumedWeight(123.5)
+	patches, err = decoded.EncodeAuxPatch(original)
+	require.NoError(err)
+	require.Len(patches, 1)
+
+	stats, _ := decoded.GetStats()
+	assert.Equal(0, patches[0].Offset%8)
+	assert.Equal(0, len(patches[0].Data)%8)
+	assert.GreaterOrEqual(patches[0].Offset+8, stats.Aux.AbsoluteOffset)
+
+	full, err := decoded.Encode()
+	require.NoError(err)
+	patched, err := openprinttag.ApplyPatches(original, patches)
+	require.NoError(err)
+	assert.Equal(full, patched[:len(full)])
+}
+
+// TestAuxPatchRefusesMainChange ensures that a change to the main region
+// is refused when producing an aux patch
+func TestAuxPatchRefusesMainChange(t *testing.T) {
+	require := require.New(t)
+
+	tag, err := openprinttag.FromYAML(dataToFill)
+	require.NoError(err)
+	tag.WithSize(304).WithAuxRegionSize(32)
+	original, err := tag.Encode()
+	require.NoError(err)
+
+	decoded, err := openprinttag.Decode(original)
+	require.NoError(err)
+	decoded.MainRegion().SetBrandName("Another Brand")
+	decoded.AuxRegion().SetConsumedWeight(10)
+
+	_, err = decoded.EncodeAuxPatch(original)
+	require.ErrorIs(err, openprinttag.ErrPatchOutsideAux)
+}
+
+// TestAuxPatchRefusesShortImage ensures that an original image smaller than the
+// encoded tag is reported as an error rather than an assertion
+func TestAuxPatchRefusesShortImage(t *testing.T) {
+	require := require.New(t)
+
+	tag, err := openprinttag.FromYAML(dataToFill)
+	require.NoError(err)
+	tag.WithSize(304).WithAuxRegionSize(32)
+	original, err := tag.Encode()
+	require.NoError(err)
+
+	decoded, err := openprinttag.Decode(original)
+	require.NoError(err)
+	_, err = decoded.EncodeAuxPatch(original[:100])
+	require.ErrorIs(err, openprinttag.ErrPatchImageTooSmall)
+}
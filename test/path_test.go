@@ -0,0 +1,144 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathSetGet(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag := openprinttag.NewOpenPrintTag().WithSize(304).WithAuxRegionSize(32)
+
+	require.NoError(tag.Set("main.material_class", "FFF"))
+	require.NoError(tag.Set("main.material_type", openprinttag.MaterialTypePETG))
+	require.NoError(tag.Set("main.min_print_temperature", "215"))
+	require.NoError(tag.Set("main.max_print_temperature", 240.0))
+	require.NoError(tag.Set("main.density", 1))
+	require.NoError(tag.Set("main.gtin", "4006381333931"))
+	require.NoError(tag.Set("main.primary_color", "#ff0000"))
+	require.NoError(tag.Set("main.tags", "matte, silk"))
+	require.NoError(tag.Set("main.certifications", []string{}))
+	require.NoError(tag.Set("main.brand_uuid", "ae5ff34e-298e-50c9-8f77-92a97fb30b09"))
+	require.NoError(tag.Set("main.manufactured_date", "2025-01-02T03:04:05Z"))
+	require.NoError(tag.Set("main.expiration_date", 1767225600))
+	require.NoError(tag.Set("aux.consumed_weight", "12.5"))
+	require.NoError(tag.Set("aux.65530", "vendor"))
+
+	// The values are seen through the region getters
+	materialClass, _ := tag.MainRegion().GetMaterialClass()
+	assert.Equal(openprinttag.MaterialClassFFF, materialClass)
+	materialType, _ := tag.MainRegion().GetMaterialType()
+	assert.Equal(openprinttag.MaterialTypePETG, materialType)
+	temperature, _ := tag.MainRegion().GetMinPrintTemperature()
+	assert.Equal(215, temperature)
+	temperature, _ = tag.MainRegion().GetMaxPrintTemperature()
+	assert.Equal(240, temperature)
+	density, _ := tag.MainRegion().GetDensity()
+	assert.Equal(1.0, density)
+	gtin, _ := tag.MainRegion().GetGtin()
+	assert.Equal(uint64(4006381333931), gtin)
+	color, _ := tag.MainRegion().GetPrimaryColor()
+	assert.Equal(openprinttag.MustNewColor("#ff0000"), color)
+	tags, _ := tag.MainRegion().GetTags()
+	assert.Equal([]openprinttag.Tag{openprinttag.TagMatte, openprinttag.TagSilk}, tags)
+	brand, _ := tag.MainRegion().GetBrandUuid()
+	assert.Equal(uuid.MustParse("ae5ff34e-298e-50c9-8f77-92a97fb30b09"), brand)
+	manufactured, _ := tag.MainRegion().GetManufacturedDate()
+	assert.Equal(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC).Unix(), manufactured.Unix())
+	consumed, _ := tag.AuxRegion().GetConsumedWeight()
+	assert.Equal(12.5, consumed)
+	assert.Equal("vendor", tag.AuxRegion().GetVendorSpecificField(65530))
+
+	// And through Get, in the getter types
+	value, found, err := tag.Get("main.min_print_temperature")
+	require.NoError(err)
+	assert.True(found)
+	assert.Equal(215, value)
+	value, _, _ = tag.Get("main.material_type")
+	assert.Equal(openprinttag.MaterialTypePETG, value)
+	value, _, _ = tag.Get("main.expiration_date")
+	assert.Equal(time.Unix(1767225600, 0), value)
+	value, _, _ = tag.Get("aux.65530")
+	assert.Equal("vendor", value)
+	_, found, err = tag.Get("main.brand_name")
+	require.NoError(err)
+	assert.False(found)
+
+	_, err = tag.Encode()
+	require.NoError(err)
+}
+
+func TestPathUnset(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag, err := openprinttag.FromYAML(dataToFill)
+	require.NoError(err)
+
+	require.NoError(tag.Unset("main.brand_name"))
+	_, found := tag.MainRegion().GetBrandName()
+	assert.False(found)
+
+	// Unsetting an absent aux region does not create one
+	empty := openprinttag.NewOpenPrintTag()
+	require.NoError(empty.Unset("aux.consumed_weight"))
+	_, found, err = empty.Get("aux.consumed_weight")
+	require.NoError(err)
+	assert.False(found)
+}
+
+func TestPathErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	tag := openprinttag.NewOpenPrintTag()
+
+	assert.ErrorIs(tag.Set("main.brand_nmae", "x"), openprinttag.ErrUnknownField)
+	assert.ErrorIs(tag.Set("brand_name", "x"), openprinttag.ErrUnknownField)
+	assert.ErrorIs(tag.Set("body.brand_name", "x"), openprinttag.ErrUnknownField)
+	assert.ErrorIs(tag.Unset("main.brand_nmae"), openprinttag.ErrUnknownField)
+
+	assert.EqualError(tag.Set("main.min_print_temperature", "hot"),
+		`field main.min_print_temperature expects an integer: "hot" is not an integer`)
+	assert.EqualError(tag.Set("main.min_print_temperature", 215.5),
+		`field main.min_print_temperature expects an integer: 215.5 is not an integer`)
+	assert.EqualError(tag.Set("main.material_type", "NOPE"),
+		"field main.material_type expects a MaterialType value: unknown enumeration: NOPE")
+	assert.EqualError(tag.Set("main.tags", "matte,shiny"),
+		"field main.tags expects a list of Tag values: unknown enumeration: shiny")
+	assert.EqualError(tag.Set("main.material_class", 99),
+		"field main.material_class expects a MaterialClass value: unknown enumeration: 99")
+	assert.EqualError(tag.Set("main.gtin", -1),
+		"field main.gtin expects a non-negative integer: -1 is negative")
+	assert.ErrorContains(tag.Set("main.primary_color", "red"), "field main.primary_color expects a color")
+	assert.ErrorContains(tag.Set("main.brand_uuid", "not-a-uuid"), "field main.brand_uuid expects a UUID")
+	assert.ErrorContains(tag.Set("main.manufactured_date", "yesterday"), "field main.manufactured_date expects a timestamp")
+	assert.ErrorContains(tag.Set("main.brand_name", 12), "field main.brand_name expects a string")
+}
@@ -0,0 +1,161 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlausibilityRules(t *testing.T) {
+	manufactured := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, test := range []struct {
+		name     string
+		code     openprinttag.RuleCode
+		setup    func(tag *openprinttag.OpenPrintTag)
+		errors   []string
+		warnings []string
+	}{
+		{
+			name: "print temperature inverted",
+			code: openprinttag.RulePrintTemperatureInverted,
+			setup: func(tag *openprinttag.OpenPrintTag) {
+				tag.MainRegion().SetMinPrintTemperature(250).SetMaxPrintTemperature(210)
+			},
+			errors: []string{"min_print_temperature of 250 °C is above max_print_temperature of 210 °C"},
+		},
+		{
+			name: "bed temperature inverted",
+			code: openprinttag.RuleBedTemperatureInverted,
+			setup: func(tag *openprinttag.OpenPrintTag) {
+				tag.MainRegion().SetMinBedTemperature(80).SetMaxBedTemperature(60)
+			},
+			errors: []string{"min_bed_temperature of 80 °C is above max_bed_temperature of 60 °C"},
+		},
+		{
+			name: "chamber temperature inverted",
+			code: openprinttag.RuleChamberTemperatureInverted,
+			setup: func(tag *openprinttag.OpenPrintTag) {
+				tag.MainRegion().SetMinChamberTemperature(50).SetMaxChamberTemperature(40)
+			},
+			errors: []string{"min_chamber_temperature of 50 °C is above max_chamber_temperature of 40 °C"},
+		},
+		{
+			name: "preheat above print range",
+			code: openprinttag.RulePreheatAbovePrintRange,
+			setup: func(tag *openprinttag.OpenPrintTag) {
+				tag.MainRegion().SetMinPrintTemperature(190).SetMaxPrintTemperature(220).SetPreheatTemperature(240)
+			},
+			warnings: []string{"preheat_temperature of 240 °C is above max_print_temperature of 220 °C"},
+		},
+		{
+			name: "actual weight deviates",
+			code: openprinttag.RuleActualWeightDeviates,
+			setup: func(tag *openprinttag.OpenPrintTag) {
+				tag.MainRegion().SetNominalNettoFullWeight(1000).SetActualNettoFullWeight(500)
+			},
+			warnings: []string{"actual_netto_full_weight of 500 g differs from nominal_netto_full_weight of 1000 g by more than 20%"},
+		},
+		{
+			name: "expiration before manufacture",
+			code: openprinttag.RuleExpirationBeforeManufacture,
+			setup: func(tag *openprinttag.OpenPrintTag) {
+				tag.MainRegion().SetManufacturedDate(manufactured).SetExpirationDate(manufactured.AddDate(0, -1, 0))
+			},
+			errors: []string{"expiration_date of 2025-05-01 is before manufactured_date of 2025-06-01"},
+		},
+		{
+			name: "container diameter inverted",
+			code: openprinttag.RuleContainerDiameterInverted,
+			setup: func(tag *openprinttag.OpenPrintTag) {
+				tag.MainRegion().SetContainerInnerDiameter(200).SetContainerOuterDiameter(200)
+			},
+			errors: []string{"container_inner_diameter of 200 mm is not less than container_outer_diameter of 200 mm"},
+		},
+		{
+			name: "negative quantities",
+			code: openprinttag.RuleNegativeQuantity,
+			setup: func(tag *openprinttag.OpenPrintTag) {
+				tag.MainRegion().SetEmptyContainerWeight(-5)
+				tag.AuxRegion().SetConsumedWeight(-1.5)
+			},
+			errors: []string{
+				"main.empty_container_weight of -5 g is negative",
+				"aux.consumed_weight of -1.5 g is negative",
+			},
+		},
+		{
+			name: "plausible data",
+			setup: func(tag *openprinttag.OpenPrintTag) {
+				tag.MainRegion().
+					SetMinPrintTemperature(190).SetMaxPrintTemperature(220).SetPreheatTemperature(170).
+					SetNominalNettoFullWeight(1000).SetActualNettoFullWeight(1012).
+					SetManufacturedDate(manufactured).SetExpirationDate(manufactured.AddDate(2, 0, 0)).
+					SetContainerInnerDiameter(52).SetContainerOuterDiameter(200)
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			tag := openprinttag.NewOpenPrintTag()
+			tag.MainRegion().SetMaterialClass(openprinttag.MaterialClassFFF).SetBrandName("Acme").SetMaterialName("Acme PLA").SetGtin(4006381333931)
+			test.setup(tag)
+			report := tag.OptCheck()
+			errors, warnings := report.Messages(openprinttag.SeverityError), report.Messages(openprinttag.SeverityWarning)
+			assert.Equal(t, test.errors, errors)
+			assert.Equal(t, test.warnings, warnings)
+			if test.code != "" {
+				assert.Len(t, report.WithCode(test.code).Issues, len(test.errors)+len(test.warnings))
+			}
+		})
+	}
+}
+
+func TestPlausibilityFieldIssues(t *testing.T) {
+	assert := assert.New(t)
+
+	tag := openprinttag.NewOpenPrintTag()
+	tag.MainRegion().SetMaterialClass(openprinttag.MaterialClassFFF).SetEmptyContainerWeight(-5)
+	tag.AuxRegion().SetConsumedWeight(-1.5)
+	issues := tag.OptCheck().WithCode(openprinttag.RuleNegativeQuantity).Issues
+	if assert.Len(issues, 2) {
+		assert.Equal("main", issues[0].Region)
+		assert.Equal("empty_container_weight", issues[0].Field)
+		assert.Equal("aux", issues[1].Region)
+		assert.Equal("consumed_weight", issues[1].Field)
+		for _, issue := range issues {
+			assert.NotNil(issue.Key)
+		}
+	}
+
+	// Rules relating several fields are not attributed to any one of them
+	tag = openprinttag.NewOpenPrintTag()
+	tag.MainRegion().SetMinPrintTemperature(230).SetMaxPrintTemperature(200)
+	issues = tag.OptCheck().WithCode(openprinttag.RulePrintTemperatureInverted).Issues
+	if assert.NotEmpty(issues) {
+		assert.Empty(issues[0].Field)
+		assert.Nil(issues[0].Key)
+	}
+}
@@ -0,0 +1,102 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProfiles checks profile lookup and that WithProfile sizes the tag
+func TestProfiles(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	profile, ok := openprinttag.LookupProfile("SLIX2")
+	require.True(ok)
+	assert.Equal(312, profile.Size)
+	assert.Equal(32, profile.AuxRegionSize)
+
+	_, ok = openprinttag.LookupProfile("no-such-tag")
+	assert.False(ok)
+
+	tag := openprinttag.NewOpenPrintTag().WithProfile(profile)
+	data, err := tag.Encode(profile.EncodeDecodeOptions()...)
+	require.NoError(err)
+	assert.Len(data, 312)
+	stats, _ := tag.GetStats()
+	require.NotNil(stats.Aux)
+
+	ntag, ok := openprinttag.LookupProfile("ntag215")
+	require.True(ok)
+	data, err = openprinttag.NewOpenPrintTag().WithProfile(ntag).Encode(ntag.EncodeDecodeOptions()...)
+	require.NoError(err)
+	assert.Equal(byte(0x10), data[1], "Type 2 CC version")
+}
+
+// TestFitsOn ensures the fits on report agrees with actually encoding the data on each profile,
+// including the aux region reserved by the profile, and leaves the tag unchanged
+func TestFitsOn(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	// Beyond about 100 bytes of vendor data, the data no longer fits on a slix2 once its aux region is reserved
+	for _, vendorSize := range []int{0, 96, 110, 130} {
+		data := dataToFill
+		if vendorSize > 0 {
+			data += fmt.Sprintf("    other:\n      655300: %s\n", strings.Repeat("x", vendorSize))
+		}
+		tag, err := openprinttag.FromYAML(data)
+		require.NoError(err)
+		before, err := tag.WithSize(504).Encode()
+		require.NoError(err)
+
+		fits, err := tag.FitsOn()
+		require.NoError(err)
+		require.Len(fits, len(openprinttag.Profiles()))
+		anyFit, anyMiss := false, false
+		for _, fit := range fits {
+			onProfile, err := openprinttag.FromYAML(data)
+			require.NoError(err)
+			_, err = onProfile.WithProfile(fit.Profile).Encode(fit.Profile.EncodeDecodeOptions()...)
+			assert.Equal(fit.Fits, err == nil, "profile %s, vendor data %d, required %d", fit.Profile.Name, vendorSize, fit.Required)
+			if fit.Profile.Name == "slix2" {
+				assert.Equal(vendorSize < 100, fit.Fits, "slix2 with vendor data %d", vendorSize)
+				assert.Equal(fit.Fits, fit.Required <= fit.Profile.Size, "slix2 estimate with vendor data %d", vendorSize)
+			}
+			anyFit = anyFit || fit.Fits
+			anyMiss = anyMiss || !fit.Fits
+		}
+		assert.True(anyFit)
+		assert.True(anyMiss)
+
+		// The tag is unchanged by the trial encodes
+		after, err := tag.Encode()
+		require.NoError(err)
+		assert.Equal(before, after)
+	}
+}
@@ -0,0 +1,100 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRecordsRoundTrip authors a tag with several foreign records either side of the
+// open print tag record and ensures they survive decode and encode in order
+func TestRecordsRoundTrip(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	smartPoster := openprinttag.NDEFRecord{TNF: openprinttag.TNFWellKnown, Type: "Sp", Payload: []byte{0xD1, 0x01, 0x02, 0x55, 0x00, 0x61}}
+	tag, err := openprinttag.FromYAML(dataToFill)
+	require.NoError(err)
+	tag.WithSize(504).
+		WithAuxRegionSize(32).
+		WithTextRecord("PLA Galaxy Black", "en").
+		WithURIRecord("https://example.com/spool").
+		WithAARRecord("com.example.spools").
+		WithTrailingRecord(smartPoster)
+
+	data, err := tag.Encode()
+	require.NoError(err)
+
+	decoded, err := openprinttag.Decode(data)
+	require.NoError(err)
+	records := decoded.Records()
+	require.Len(records, 4)
+	assert.Equal(2, decoded.RecordsBeforeOpenPrintTag())
+
+	uri, ok := records[0].URI()
+	assert.True(ok)
+	assert.Equal("https://example.com/spool", uri)
+
+	text, language, ok := records[1].Text()
+	assert.True(ok)
+	assert.Equal("PLA Galaxy Black", text)
+	assert.Equal("en", language)
+
+	pkg, ok := records[2].AARPackage()
+	assert.True(ok)
+	assert.Equal("com.example.spools", pkg)
+
+	assert.Equal(smartPoster, records[3])
+
+	reencoded, err := decoded.Encode()
+	require.NoError(err)
+	assert.Equal(data, reencoded)
+}
+
+// TestRecordsReduceSpace ensures that records are accounted for in the space available to the payload
+func TestRecordsReduceSpace(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	plain := openprinttag.NewOpenPrintTag().WithSize(200)
+	_, err := plain.Encode()
+	require.NoError(err)
+	plainStats, _ := plain.GetStats()
+
+	withRecords := openprinttag.NewOpenPrintTag().WithSize(200).
+		WithRecord(openprinttag.NewMIMERecord("text/plain", make([]byte, 20))).
+		WithAARRecord("com.example")
+	_, err = withRecords.Encode()
+	require.NoError(err)
+	recordStats, _ := withRecords.GetStats()
+
+	// Each record has a 3 byte header plus type and payload
+	expected := (3 + len("text/plain") + 20) + (3 + len("android.com:pkg") + len("com.example"))
+	assert.Equal(plainStats.Root.PayloadSize-expected, recordStats.Root.PayloadSize)
+
+	withRecords.RemoveRecords().WithURIRecord("")
+	assert.Empty(withRecords.Records())
+}
@@ -0,0 +1,115 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestValidateReport(t *testing.T) {
+	assert := assert.New(t)
+
+	report := openprinttag.NewOpenPrintTag().Validate()
+	assert.True(report.HasErrors())
+
+	errors := report.WithSeverity(openprinttag.SeverityError)
+	require.Len(t, errors.Issues, 1)
+	issue := errors.Issues[0]
+	assert.Equal(openprinttag.RuleRequiredFieldMissing, issue.Code)
+	assert.Equal("main", issue.Region)
+	assert.Equal("material_class", issue.Field)
+	require.NotNil(t, issue.Key)
+	assert.Equal(8, *issue.Key)
+	assert.Equal("field MaterialClass (material_class/8) is required", issue.String())
+
+	gtin := report.Filter(func(issue openprinttag.Issue) bool { return issue.Field == "gtin" })
+	require.Len(t, gtin.Issues, 1)
+	assert.Equal(openprinttag.SeverityWarning, gtin.Issues[0].Severity)
+	assert.Equal(openprinttag.RuleRecommendedFieldMissing, gtin.Issues[0].Code)
+
+	assert.Empty(report.InRegion("aux").Issues)
+	assert.Len(report.InRegion("main").Issues, len(report.Issues))
+	assert.Empty(report.WithCode(openprinttag.RuleMaxLengthExceeded).Issues)
+
+	// Region level issues concern no field
+	tag := openprinttag.NewOpenPrintTag().WithAuxRegionSize(8)
+	sizeIssues := tag.Validate().WithCode(openprinttag.RuleAuxRegionSize)
+	require.Len(t, sizeIssues.Issues, 1)
+	assert.Equal(openprinttag.SeverityError, sizeIssues.Issues[0].Severity)
+	assert.Nil(sizeIssues.Issues[0].Key)
+	assert.False(tag.IsValid())
+}
+
+func TestOptCheckReportCodes(t *testing.T) {
+	assert := assert.New(t)
+
+	tag := openprinttag.NewOpenPrintTag()
+	tag.MainRegion().SetMaterialClass(openprinttag.MaterialClassFFF).SetGtin(4006381333932).
+		SetMinPrintTemperature(230).SetMaxPrintTemperature(210)
+	report := tag.OptCheck()
+
+	assert.Len(report.WithCode(openprinttag.RuleInvalidGTIN).Issues, 1)
+	assert.Len(report.WithCode(openprinttag.RulePrintTemperatureInverted).Issues, 1)
+	assert.Len(report.WithCode(openprinttag.RuleUUIDNotDeducible).Issues, 3)
+	assert.Len(report.WithCode(openprinttag.RuleAuxRegionMissing).Issues, 1)
+	assert.Len(report.WithSeverity(openprinttag.SeverityError).Issues, 2)
+
+	// Issues from checks that concern a single field identify it
+	invalid := report.WithCode(openprinttag.RuleInvalidGTIN).Issues[0]
+	assert.Equal("main", invalid.Region)
+	assert.Equal("gtin", invalid.Field)
+	require.NotNil(t, invalid.Key)
+	assert.Equal(4, *invalid.Key)
+}
+
+func TestReportSerialization(t *testing.T) {
+	assert := assert.New(t)
+
+	tag := openprinttag.NewOpenPrintTag()
+	tag.MainRegion().SetMaterialClass(openprinttag.MaterialClassFFF).SetGtin(4006381333932)
+	report := tag.OptCheck()
+
+	y, err := report.ToYAML()
+	require.NoError(t, err)
+	assert.Contains(y, "severity: error")
+	assert.Contains(y, "code: invalid_gtin")
+	assert.Contains(y, "key: 4")
+	fromYAML := openprinttag.Report{}
+	require.NoError(t, yaml.Unmarshal([]byte(y), &fromYAML))
+	assert.Equal(report.Issues, fromYAML.Issues)
+
+	j, err := report.ToJSON()
+	require.NoError(t, err)
+	assert.Contains(j, `"severity": "note"`)
+	fromJSON := openprinttag.Report{}
+	require.NoError(t, json.Unmarshal([]byte(j), &fromJSON))
+	assert.Equal(report.Issues, fromJSON.Issues)
+
+	_, err = openprinttag.ParseSeverity("fatal")
+	assert.EqualError(err, "unknown severity: fatal")
+}
@@ -0,0 +1,126 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newResinTag returns a 1l resin bottle with 115g consumed
+func newResinTag() *openprinttag.OpenPrintTag {
+	tag := openprinttag.NewOpenPrintTag().WithSize(304).WithAuxRegionSize(32)
+	tag.MainRegion().
+		SetMaterialClass(openprinttag.MaterialClassSLA).
+		SetContainerVolumetricCapacity(1000).
+		SetDensity(1.15).
+		SetViscosity18C(800).
+		SetViscosity25C(500).
+		SetViscosity40C(200)
+	tag.AuxRegion().SetConsumedWeight(115)
+	return tag
+}
+
+func TestViscosityAt(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag := newResinTag()
+	assert.Equal([]openprinttag.ViscosityPoint{{Temperature: 18, Viscosity: 800}, {Temperature: 25, Viscosity: 500}, {Temperature: 40, Viscosity: 200}}, tag.ViscosityPoints())
+
+	viscosity, found := tag.ViscosityAt(25)
+	require.True(found)
+	assert.Equal(500.0, viscosity)
+
+	// Half way between 25°C and 40°C is the geometric mean
+	viscosity, found = tag.ViscosityAt(32.5)
+	require.True(found)
+	assert.InDelta(316.23, viscosity, 0.01)
+
+	// Extrapolated from the nearest points
+	viscosity, found = tag.ViscosityAt(55)
+	require.True(found)
+	assert.InDelta(80, viscosity, 0.01)
+	viscosity, found = tag.ViscosityAt(11)
+	require.True(found)
+	assert.InDelta(1280, viscosity, 0.01)
+
+	// A single point only gives the viscosity at its own temperature
+	tag.MainRegion().ClearViscosity18C().ClearViscosity40C()
+	_, found = tag.ViscosityAt(30)
+	assert.False(found)
+	viscosity, found = tag.ViscosityAt(25)
+	assert.True(found)
+	assert.Equal(500.0, viscosity)
+}
+
+func TestRemainingVolume(t *testing.T) {
+	assert := assert.New(t)
+
+	tag := newResinTag()
+	volume, found := tag.RemainingVolume()
+	assert.True(found)
+	assert.InDelta(900, volume, 0.0001)
+
+	tag.MainRegion().ClearDensity()
+	_, found = tag.RemainingVolume()
+	assert.False(found)
+
+	tag.AuxRegion().ClearConsumedWeight()
+	volume, found = tag.RemainingVolume()
+	assert.True(found)
+	assert.Equal(1000.0, volume)
+}
+
+func TestStirringDue(t *testing.T) {
+	assert := assert.New(t)
+
+	tag := newResinTag()
+	assert.Equal(openprinttag.DefaultStirInterval, tag.StirInterval())
+	assert.True(tag.StirringDue(), "never stirred")
+
+	tag.AuxRegion().SetLastStirTime(time.Now().Add(-2 * time.Hour))
+	assert.False(tag.StirringDue())
+	assert.True(tag.WithStirInterval(time.Hour).StirringDue())
+
+	tag.AuxRegion().SetLastStirTime(time.Now().Add(-48 * time.Hour))
+	assert.True(tag.WithStirInterval(0).StirringDue())
+
+	computed := tag.ComputeValues()
+	if assert.NotNil(computed.StirringDue) {
+		assert.True(*computed.StirringDue)
+	}
+	if assert.NotNil(computed.RemainingVolume) {
+		assert.Equal(900.0, *computed.RemainingVolume)
+	}
+
+	// Filament has no stirring requirement, and no remaining volume even with a volumetric capacity
+	filament := newFilamentTag()
+	filament.MainRegion().SetContainerVolumetricCapacity(1000)
+	computed = filament.ComputeValues()
+	assert.Nil(computed.StirringDue)
+	assert.Nil(computed.RemainingVolume)
+}
@@ -0,0 +1,161 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type schemaNode struct {
+	Ref         string                 `json:"$ref"`
+	Type        string                 `json:"type"`
+	MaxLength   int                    `json:"maxLength"`
+	MaxItems    int                    `json:"maxItems"`
+	Enum        []string               `json:"enum"`
+	Items       *schemaNode            `json:"items"`
+	AnyOf       []*schemaNode          `json:"anyOf"`
+	Properties  map[string]*schemaNode `json:"properties"`
+	Defs        map[string]*schemaNode `json:"$defs"`
+	Key         *int                   `json:"x-key"`
+	Unit        string                 `json:"x-unit"`
+	Required    bool                   `json:"x-required"`
+	Recommended bool                   `json:"x-recommended"`
+	Deprecated  bool                   `json:"deprecated"`
+}
+
+func loadSchema(t *testing.T) *schemaNode {
+	var schema schemaNode
+	require.NoError(t, json.Unmarshal(openprinttag.DataSchema(), &schema))
+	return &schema
+}
+
+func TestSchemaFields(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	schema := loadSchema(t)
+	regions := schema.Properties["data"].Properties
+	require.Contains(regions, "meta")
+	require.Contains(regions, "main")
+	require.Contains(regions, "aux")
+	main := regions["main"].Properties
+
+	assert.Equal(8, *main["material_class"].Key)
+	assert.True(main["material_class"].Required)
+	assert.True(main["brand_name"].Recommended)
+	assert.Equal(31, main["brand_name"].MaxLength)
+	assert.Equal("g", main["nominal_netto_full_weight"].Unit)
+	assert.Equal("°C", main["max_print_temperature"].Unit)
+	assert.Equal("array", main["tags"].Type)
+	assert.Equal(16, main["tags"].MaxItems)
+	assert.Equal("g", regions["aux"].Properties["consumed_weight"].Unit)
+
+	// Every field of the example data file is described by the schema
+	var doc struct {
+		Data map[string]map[string]any `yaml:"data"`
+	}
+	require.NoError(yaml.Unmarshal([]byte(dataToFill), &doc))
+	for region, fields := range doc.Data {
+		for name := range fields {
+			assert.Contains(regions[region].Properties, name, "%s.%s", region, name)
+		}
+	}
+}
+
+func TestSchemaEnums(t *testing.T) {
+	assert := assert.New(t)
+
+	schema := loadSchema(t)
+	main := schema.Properties["data"].Properties["main"].Properties
+
+	enumValues := func(ref string) []string {
+		node := schema.Defs[ref[len("#/$defs/"):]]
+		if node == nil || len(node.AnyOf) == 0 {
+			return nil
+		}
+		return sorted(node.AnyOf[0].Enum)
+	}
+	assert.Equal(mapValues(openprinttag.MaterialClassMap), enumValues(main["material_class"].Ref))
+	assert.Equal(mapValues(openprinttag.MaterialTypeMap), enumValues(main["material_type"].Ref))
+	assert.Equal(mapValues(openprinttag.TagMap), enumValues(main["tags"].Items.Ref))
+	assert.Equal(mapValues(openprinttag.MaterialCertificationsMap), enumValues(main["certifications"].Items.Ref))
+}
+
+// TestSchemaValidation validates data files against the schema, including quantities
+// with units and enumeration values unknown to this version
+func TestSchemaValidation(t *testing.T) {
+	require := require.New(t)
+
+	schema, err := jsonschema.CompileString("data_schema.json", string(openprinttag.DataSchema()))
+	require.NoError(err)
+
+	validate := func(document string) error {
+		var value any
+		require.NoError(json.Unmarshal([]byte(document), &value))
+		return schema.Validate(value)
+	}
+
+	documents := map[string]string{
+		"quantities": `{"data": {"main": {"material_class": "FFF", "nominal_netto_full_weight": "1 kg",
+			"max_print_temperature": "410 F", "filament_diameter": "1.75mm", "density": 1.24}}}`,
+		"unknown enumeration values": `{"data": {"main": {"material_class": "FFF", "material_type": 97,
+			"tags": ["glitter", 250]}}}`,
+	}
+	for name, document := range documents {
+		require.NoError(validate(document), name)
+		_, err := openprinttag.FromJSON(document)
+		require.NoError(err, name)
+	}
+
+	// Misspelled enumeration values, malformed quantities and unknown fields are still rejected
+	for _, document := range []string{
+		`{"data": {"main": {"material_type": "PLAA"}}}`,
+		`{"data": {"main": {"nominal_netto_full_weight": "heavy"}}}`,
+		`{"data": {"main": {"material_type": -1}}}`,
+		`{"data": {"main": {"brand": "Prusament"}}}`,
+	} {
+		require.Error(validate(document), strings.TrimSpace(document))
+	}
+}
+
+func sorted(values []string) []string {
+	values = slices.Clone(values)
+	slices.Sort(values)
+	return values
+}
+
+func mapValues(m map[uint64]string) []string {
+	values := make([]string, 0, len(m))
+	for _, value := range m {
+		values = append(values, value)
+	}
+	return sorted(values)
+}
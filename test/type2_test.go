@@ -0,0 +1,91 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestType2Layout encodes a tag for each NTAG variant and ensures the CC, Lock Control TLV
+// and capacity are correct, and that the image decodes back to the same tag
+func TestType2Layout(t *testing.T) {
+	tests := []struct {
+		variant     openprinttag.NTAGVariant
+		cc          []byte
+		lockControl []byte
+	}{
+		{openprinttag.NTAG213, []byte{0xE1, 0x10, 0x12, 0x00}, []byte{0x01, 0x03, 0xA0, 0x0C, 0x34}},
+		{openprinttag.NTAG215, []byte{0xE1, 0x10, 0x3E, 0x00}, []byte{0x01, 0x03, 0x88, 0x38, 0x36}},
+		{openprinttag.NTAG216, []byte{0xE1, 0x10, 0x6D, 0x00}, []byte{0x01, 0x03, 0xE8, 0x67, 0x36}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.variant.String(), func(t *testing.T) {
+			require := require.New(t)
+			assert := assert.New(t)
+
+			tag := openprinttag.NewOpenPrintTag().WithNTAG(test.variant)
+			tag.MainRegion().SetBrandName("Brand").SetMaterialName("PLA Black")
+			data, err := tag.Encode(openprinttag.Type2Layout)
+			require.NoError(err)
+
+			assert.LessOrEqual(len(data), test.variant.ImageSize())
+			assert.Equal(test.cc, data[0:4])
+			assert.Equal(test.lockControl, data[4:9])
+			assert.Equal(byte(0x03), data[9], "NDEF TLV follows the Lock Control TLV")
+
+			decoded, err := openprinttag.Decode(data, openprinttag.Type2Layout)
+			require.NoError(err)
+			assert.True(openprinttag.Equal(tag, decoded))
+
+			reencoded, err := decoded.Encode(openprinttag.Type2Layout)
+			require.NoError(err)
+			assert.Equal(data, reencoded)
+		})
+	}
+}
+
+// TestType2NullTLV ensures NULL TLV padding ahead of the NDEF TLV is skipped
+func TestType2NullTLV(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag := openprinttag.NewOpenPrintTag().WithNTAG(openprinttag.NTAG213)
+	tag.MainRegion().SetBrandName("Brand")
+	data, err := tag.Encode(openprinttag.Type2Layout)
+	require.NoError(err)
+
+	padded := slices.Insert(slices.Clone(data), 9, 0x00, 0x00)
+	decoded, err := openprinttag.Decode(padded, openprinttag.Type2Layout)
+	require.NoError(err)
+	brand, _ := decoded.MainRegion().GetBrandName()
+	assert.Equal("Brand", brand)
+
+	// A CC that declares more memory than the image holds is rejected
+	_, err = openprinttag.Decode(data[0:64], openprinttag.Type2Layout)
+	assert.ErrorIs(err, openprinttag.ErrTruncated)
+}
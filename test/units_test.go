@@ -0,0 +1,136 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuantity(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	for str, expected := range map[string]openprinttag.Quantity{
+		"1.75mm":    {Value: 1.75, Unit: openprinttag.UnitMillimeter},
+		"2.2 lb":    {Value: 2.2, Unit: "lb"},
+		"410 F":     {Value: 410, Unit: "°F"},
+		" 60 degC ": {Value: 60, Unit: openprinttag.UnitCelsius},
+		"1.24 g/cc": {Value: 1.24, Unit: openprinttag.UnitGramPerCubicCentimeter},
+		"42":        {Value: 42},
+	} {
+		quantity, err := openprinttag.ParseQuantity(str)
+		require.NoError(err, str)
+		assert.Equal(expected, quantity, str)
+	}
+
+	_, err := openprinttag.ParseQuantity("5 furlongs")
+	assert.EqualError(err, "unknown unit: furlongs")
+	_, err = openprinttag.ParseQuantity("heavy")
+	assert.EqualError(err, `"heavy" is not a quantity`)
+}
+
+func TestQuantityConvert(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	grams, err := openprinttag.Quantity{Value: 1, Unit: "kg"}.ConvertTo(openprinttag.UnitGram)
+	require.NoError(err)
+	assert.Equal(openprinttag.Quantity{Value: 1000, Unit: openprinttag.UnitGram}, grams)
+
+	celsius, err := openprinttag.Quantity{Value: 410, Unit: "F"}.ConvertTo(openprinttag.UnitCelsius)
+	require.NoError(err)
+	assert.InDelta(210, celsius.Value, 0.01)
+
+	fahrenheit, err := openprinttag.Quantity{Value: 100, Unit: openprinttag.UnitCelsius}.ConvertTo("°F")
+	require.NoError(err)
+	assert.InDelta(212, fahrenheit.Value, 0.0001)
+
+	minutes, err := openprinttag.Quantity{Value: 4, Unit: "hours"}.ConvertTo(openprinttag.UnitMinute)
+	require.NoError(err)
+	assert.InDelta(240, minutes.Value, 0.0001)
+
+	_, err = openprinttag.Quantity{Value: 1, Unit: "kg"}.ConvertTo(openprinttag.UnitMillimeter)
+	assert.EqualError(err, "cannot convert kg to mm")
+
+	assert.Equal("1.75 mm", openprinttag.Quantity{Value: 1.75, Unit: openprinttag.UnitMillimeter}.String())
+}
+
+func TestQuantityPaths(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag := openprinttag.NewOpenPrintTag()
+	require.NoError(tag.Set("main.nominal_netto_full_weight", "2.2 lb"))
+	assert.InDelta(997.9, firstReturn(tag.MainRegion().GetNominalNettoFullWeight()), 0.01)
+
+	require.NoError(tag.SetQuantity("main.max_print_temperature", openprinttag.Quantity{Value: 446, Unit: "°F"}))
+	assert.Equal(230, firstReturn(tag.MainRegion().GetMaxPrintTemperature()))
+
+	quantity, found, err := tag.GetQuantity("main.max_print_temperature")
+	require.NoError(err)
+	assert.True(found)
+	assert.Equal(openprinttag.Quantity{Value: 230, Unit: openprinttag.UnitCelsius}, quantity)
+
+	_, found, err = tag.GetQuantity("main.density")
+	assert.NoError(err)
+	assert.False(found)
+	_, _, err = tag.GetQuantity("main.brand_name")
+	assert.EqualError(err, "field main.brand_name is not numeric")
+
+	assert.EqualError(tag.Set("main.density", "5 mm"),
+		"field main.density expects a number: cannot convert mm to g/cm³")
+	assert.EqualError(tag.SetQuantity("main.brand_name", openprinttag.Quantity{Value: 1}),
+		"field main.brand_name is not numeric")
+}
+
+func TestQuantityImport(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag, err := openprinttag.FromYAML(`data:
+  main:
+    material_class: FFF
+    nominal_netto_full_weight: 1 kg
+    filament_diameter: 1.75mm
+    min_print_temperature: 410 F
+    drying_time: 4 h
+`)
+	require.NoError(err)
+	assert.Equal(1000.0, firstReturn(tag.MainRegion().GetNominalNettoFullWeight()))
+	assert.Equal(1.75, firstReturn(tag.MainRegion().GetFilamentDiameter()))
+	assert.Equal(210, firstReturn(tag.MainRegion().GetMinPrintTemperature()))
+	assert.Equal(240, firstReturn(tag.MainRegion().GetDryingTime()))
+
+	tag, err = openprinttag.FromJSON(`{"data": {"main": {"material_class": "FFF", "empty_container_weight": "8 oz", "density": 1.24}}}`)
+	require.NoError(err)
+	assert.InDelta(226.8, firstReturn(tag.MainRegion().GetEmptyContainerWeight()), 0.01)
+	assert.Equal(1.24, firstReturn(tag.MainRegion().GetDensity()))
+
+	_, err = openprinttag.FromYAML("data:\n  main:\n    density: 5 mm\n")
+	assert.EqualError(err, "line 3: field main.density expects a number: cannot convert mm to g/cm³")
+	_, err = openprinttag.FromJSON(`{"data": {"main": {"min_print_temperature": "hot"}}}`)
+	assert.EqualError(err, `field main.min_print_temperature expects an integer: "hot" is not a quantity`)
+}
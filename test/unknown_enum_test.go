@@ -0,0 +1,87 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cjbearman/openprinttag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUnknownEnumRoundTrip ensures that enumeration values from a newer specification
+// survive binary, YAML and JSON round trips as numbers
+func TestUnknownEnumRoundTrip(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	tag, err := openprinttag.FromYAML(dataToFill)
+	require.NoError(err)
+	tag.WithSize(304).WithAuxRegionSize(32)
+	tag.MainRegion().
+		SetMaterialType(openprinttag.MaterialType(97)).
+		SetTags([]openprinttag.Tag{openprinttag.TagGlitter, openprinttag.Tag(9999)})
+
+	output, err := tag.Encode()
+	require.NoError(err)
+	decoded, err := openprinttag.Decode(output)
+	require.NoError(err)
+	assert.Equal(openprinttag.MaterialType(97), firstReturn(decoded.MainRegion().GetMaterialType()))
+	assert.Equal([]openprinttag.Tag{openprinttag.TagGlitter, openprinttag.Tag(9999)}, firstReturn(decoded.MainRegion().GetTags()))
+
+	yamlData, err := decoded.ToYAML()
+	require.NoError(err)
+	assert.Contains(yamlData, "material_type: 97")
+	assert.Contains(yamlData, "- 9999")
+	fromYAML, err := openprinttag.FromYAML(yamlData)
+	require.NoError(err)
+	assert.True(openprinttag.Equal(decoded, fromYAML))
+
+	jsonData, err := decoded.ToJSON()
+	require.NoError(err)
+	assert.Contains(jsonData, `"material_type": 97`)
+	fromJSON, err := openprinttag.FromJSON(jsonData)
+	require.NoError(err)
+	assert.True(openprinttag.Equal(decoded, fromJSON))
+
+	// Unknown names are still rejected
+	_, err = openprinttag.FromYAML(strings.Replace(yamlData, "material_type: 97", "material_type: NOPE", 1))
+	assert.ErrorContains(err, "unknown enumeration: NOPE")
+}
+
+func TestUnknownEnumWarning(t *testing.T) {
+	assert := assert.New(t)
+
+	tag := openprinttag.NewOpenPrintTag()
+	tag.MainRegion().
+		SetMaterialType(openprinttag.MaterialType(97)).
+		SetTags([]openprinttag.Tag{openprinttag.TagGlitter, openprinttag.Tag(9999)})
+
+	report := tag.OptCheck()
+	errors, warnings := report.Messages(openprinttag.SeverityError), report.Messages(openprinttag.SeverityWarning)
+	assert.Empty(errors)
+	assert.Contains(warnings, "field MaterialType (material_type/9) has unknown enumeration value 97")
+	assert.Contains(warnings, "field Tags (tags/28) has unknown enumeration value 9999")
+	assert.Equal("9999", openprinttag.Tag(9999).DisplayName())
+}
@@ -0,0 +1,146 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import "fmt"
+
+// NTAGVariant identifies a member of the NXP NTAG21x family of NFC Forum Type 2 tags
+type NTAGVariant int
+
+const (
+	// NTAG213 has 144 bytes of user memory
+	NTAG213 NTAGVariant = iota
+	// NTAG215 has 504 bytes of user memory
+	NTAG215
+	// NTAG216 has 888 bytes of user memory
+	NTAG216
+)
+
+// ntagVariantInfo describes the memory of an NTAG variant
+type ntagVariantInfo struct {
+	name string
+
+	// userMemory is the number of bytes of user memory, starting at page 4
+	userMemory int
+
+	// dataAreaSize is the size declared in the factory programmed capability container
+	dataAreaSize int
+}
+
+var ntagVariants = map[NTAGVariant]ntagVariantInfo{
+	NTAG213: {name: "NTAG213", userMemory: 144, dataAreaSize: 144},
+	NTAG215: {name: "NTAG215", userMemory: 504, dataAreaSize: 496},
+	NTAG216: {name: "NTAG216", userMemory: 888, dataAreaSize: 872},
+}
+
+// String returns the name of the variant
+func (v NTAGVariant) String() string {
+	if info, ok := ntagVariants[v]; ok {
+		return info.name
+	}
+	return fmt.Sprintf("NTAG(%d)", int(v))
+}
+
+// UserMemory returns the physical user memory of the variant in bytes
+func (v NTAGVariant) UserMemory() int {
+	return ntagVariants[v].userMemory
+}
+
+// DataAreaSize returns the usable NDEF data area of the variant in bytes, as declared
+// in its capability container
+func (v NTAGVariant) DataAreaSize() int {
+	return ntagVariants[v].dataAreaSize
+}
+
+// ImageSize returns the size of the Type 2 image for the variant, which is
+// the 4 byte capability container page followed by the data area
+func (v NTAGVariant) ImageSize() int {
+	return type2CCSize + v.DataAreaSize()
+}
+
+// WithNTAG sizes the tag for an NTAG21x variant, to be encoded with Type2Layout
+func (o *OpenPrintTag) WithNTAG(variant NTAGVariant) *OpenPrintTag {
+	o.size = variant.ImageSize()
+	o.blockSize = type2PageSize
+	return o
+}
+
+const (
+	// type2PageSize is the size of a Type 2 tag page
+	type2PageSize = 4
+
+	// type2CCSize is the size of the capability container (page 3)
+	type2CCSize = 4
+
+	// type2DataAreaAddress is the byte address of the data area (page 4)
+	type2DataAreaAddress = 16
+
+	// type2StaticDataAreaSize is the largest data area that is covered entirely by the static lock bytes
+	type2StaticDataAreaSize = 48
+
+	// type2BytesLockedPerLockBitExponent gives 8 bytes (2^3) locked per dynamic lock bit
+	type2BytesLockedPerLockBitExponent = 3
+)
+
+// type2CapabilityContainer returns the Type 2 capability container for the given data area size
+func type2CapabilityContainer(dataAreaSize int) []byte {
+	return []byte{
+		0xE1,                   // Magic Number
+		0x10,                   // Version 1.0
+		byte(dataAreaSize / 8), // Data area size
+		0x00,                   // Read/write access without restriction
+	}
+}
+
+// type2LockControlTLV returns the Lock Control TLV describing the dynamic lock bits for
+// the given data area size, or nil where the data area needs no dynamic lock bits
+// The dynamic lock bytes follow the physical user memory, which for the NTAG variants
+// can exceed the data area declared in the capability container
+func type2LockControlTLV(dataAreaSize int) []byte {
+	if dataAreaSize <= type2StaticDataAreaSize {
+		return nil
+	}
+
+	userMemory := dataAreaSize
+	for _, info := range ntagVariants {
+		if info.dataAreaSize == dataAreaSize {
+			userMemory = info.userMemory
+		}
+	}
+	lockAddress := type2DataAreaAddress + userMemory
+	lockBits := (dataAreaSize - type2StaticDataAreaSize + 7) / 8
+
+	// The address is expressed as a page address and byte offset (4 bits each) using a page size
+	// which is a power of two, we use the smallest that can represent the address
+	pageSizeExponent := 0
+	for lockAddress>>pageSizeExponent > 0x0F || lockAddress&(1<<pageSizeExponent-1) > 0x0F {
+		pageSizeExponent++
+	}
+
+	return []byte{
+		0x01, // Lock Control TLV
+		0x03, // Length
+		byte(lockAddress>>pageSizeExponent)<<4 | byte(lockAddress&(1<<pageSizeExponent-1)),
+		byte(lockBits),
+		byte(type2BytesLockedPerLockBitExponent<<4 | pageSizeExponent),
+	}
+}
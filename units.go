@@ -0,0 +1,370 @@
+// MIT License
+//
+// # Copyright (c) 2026 Christopher J Bearman
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package openprinttag
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Unit is a unit of measure, the constants are the units used by the specification
+type Unit string
+
+const (
+	UnitGram                   Unit = "g"
+	UnitMillimeter             Unit = "mm"
+	UnitCelsius                Unit = "°C"
+	UnitGramPerCubicCentimeter Unit = "g/cm³"
+	UnitMilliPascalSecond      Unit = "mPa·s"
+	UnitMilliliter             Unit = "ml"
+	UnitNanometer              Unit = "nm"
+	UnitMinute                 Unit = "min"
+)
+
+// unitDefinition describes how to convert a unit to the specification unit of
+// its dimension: spec value = value * scale + offset
+type unitDefinition struct {
+	unit      Unit
+	dimension Unit
+	scale     float64
+	offset    float64
+}
+
+// unitDefinitions lists every unit understood, the first unit of each dimension
+// is the specification unit
+var unitDefinitions = []unitDefinition{
+	{UnitGram, UnitGram, 1, 0},
+	{"mg", UnitGram, 0.001, 0},
+	{"kg", UnitGram, 1000, 0},
+	{"oz", UnitGram, 28.349523125, 0},
+	{"lb", UnitGram, 453.59237, 0},
+
+	{UnitMillimeter, UnitMillimeter, 1, 0},
+	{"µm", UnitMillimeter, 0.001, 0},
+	{"cm", UnitMillimeter, 10, 0},
+	{"m", UnitMillimeter, 1000, 0},
+	{"in", UnitMillimeter, 25.4, 0},
+	{"ft", UnitMillimeter, 304.8, 0},
+
+	{UnitNanometer, UnitNanometer, 1, 0},
+
+	{UnitCelsius, UnitCelsius, 1, 0},
+	{"°F", UnitCelsius, 5.0 / 9.0, -32 * 5.0 / 9.0},
+	{"K", UnitCelsius, 1, -273.15},
+
+	{UnitGramPerCubicCentimeter, UnitGramPerCubicCentimeter, 1, 0},
+	{"kg/m³", UnitGramPerCubicCentimeter, 0.001, 0},
+
+	{UnitMilliPascalSecond, UnitMilliPascalSecond, 1, 0},
+	{"Pa·s", UnitMilliPascalSecond, 1000, 0},
+
+	{UnitMilliliter, UnitMilliliter, 1, 0},
+	{"l", UnitMilliliter, 1000, 0},
+
+	{UnitMinute, UnitMinute, 1, 0},
+	{"s", UnitMinute, 1.0 / 60.0, 0},
+	{"h", UnitMinute, 60, 0},
+}
+
+// unitAliases maps alternative spellings (lower case, without spaces) to units
+var unitAliases = map[string]Unit{
+	"gram": UnitGram, "grams": UnitGram,
+	"kilogram": "kg", "kilograms": "kg",
+	"ounce": "oz", "ounces": "oz",
+	"lbs": "lb", "pound": "lb", "pounds": "lb",
+	"um": "µm", "μm": "µm", "micron": "µm", "microns": "µm",
+	"inch": "in", "inches": "in", "\"": "in",
+	"c": UnitCelsius, "degc": UnitCelsius, "celsius": UnitCelsius,
+	"f": "°F", "degf": "°F", "fahrenheit": "°F",
+	"kelvin": "K",
+	"g/cm3":  UnitGramPerCubicCentimeter, "g/cc": UnitGramPerCubicCentimeter, "g/ml": UnitGramPerCubicCentimeter,
+	"kg/m3": "kg/m³",
+	"mpas":  UnitMilliPascalSecond, "mpa.s": UnitMilliPascalSecond, "mpa*s": UnitMilliPascalSecond, "cp": UnitMilliPascalSecond,
+	"pas": "Pa·s", "pa.s": "Pa·s", "pa*s": "Pa·s",
+	"cm3": UnitMilliliter, "cm³": UnitMilliliter, "cc": UnitMilliliter,
+	"liter": "l", "litre": "l", "liters": "l", "litres": "l",
+	"mins": UnitMinute, "minute": UnitMinute, "minutes": UnitMinute,
+	"sec": "s", "second": "s", "seconds": "s",
+	"hr": "h", "hour": "h", "hours": "h",
+}
+
+// lookupUnit finds the definition of a unit by symbol or alias, ignoring case and spaces
+func lookupUnit(unit Unit) (unitDefinition, error) {
+	normalized := strings.ToLower(strings.ReplaceAll(string(unit), " ", ""))
+	if alias, found := unitAliases[normalized]; found {
+		normalized = strings.ToLower(string(alias))
+	}
+	for _, def := range unitDefinitions {
+		if strings.ToLower(string(def.unit)) == normalized {
+			return def, nil
+		}
+	}
+	return unitDefinition{}, fmt.Errorf("unknown unit: %s", unit)
+}
+
+// Quantity is a value with a unit of measure
+type Quantity struct {
+	Value float64
+	Unit  Unit
+}
+
+// String returns the quantity as value and unit, for example "1.75 mm"
+func (q Quantity) String() string {
+	value := strconv.FormatFloat(q.Value, 'f', -1, 64)
+	if q.Unit == "" {
+		return value
+	}
+	return value + " " + string(q.Unit)
+}
+
+// ConvertTo returns the quantity converted to another unit of the same dimension
+// A quantity without a unit is assumed to already be in the requested unit
+func (q Quantity) ConvertTo(to Unit) (Quantity, error) {
+	if q.Unit == "" {
+		return Quantity{Value: q.Value, Unit: to}, nil
+	}
+	fromDef, err := lookupUnit(q.Unit)
+	if err != nil {
+		return Quantity{}, err
+	}
+	toDef, err := lookupUnit(to)
+	if err != nil {
+		return Quantity{}, err
+	}
+	if fromDef.dimension != toDef.dimension {
+		return Quantity{}, fmt.Errorf("cannot convert %s to %s", fromDef.unit, toDef.unit)
+	}
+	specValue := q.Value*fromDef.scale + fromDef.offset
+	return Quantity{Value: (specValue - toDef.offset) / toDef.scale, Unit: toDef.unit}, nil
+}
+
+// quantityPattern matches a number followed by an optional unit
+var quantityPattern = regexp.MustCompile(`^([-+]?(?:[0-9]+\.?[0-9]*|\.[0-9]+)(?:[eE][-+]?[0-9]+)?)\s*(.*)$`)
+
+// ParseQuantity parses a number with an optional unit, for example "1 kg", "410 F" or "1.75mm"
+// The unit must be one that ConvertTo understands
+func ParseQuantity(str string) (Quantity, error) {
+	match := quantityPattern.FindStringSubmatch(strings.TrimSpace(str))
+	if match == nil {
+		return Quantity{}, fmt.Errorf("%q is not a quantity", str)
+	}
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return Quantity{}, fmt.Errorf("%q is not a quantity", str)
+	}
+	if match[2] == "" {
+		return Quantity{Value: value}, nil
+	}
+	def, err := lookupUnit(Unit(match[2]))
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{Value: value, Unit: def.unit}, nil
+}
+
+// quantityFieldValue converts a quantity to the unit of a field, returning a value of the
+// field's type, integer fields are rounded to the nearest whole number
+func quantityFieldValue(info FieldInfo, quantity Quantity) (any, error) {
+	if info.Unit == "" {
+		if quantity.Unit != "" {
+			return nil, fmt.Errorf("field %s.%s has no unit, got %s", info.Region, info.Name, quantity.Unit)
+		}
+	} else {
+		converted, err := quantity.ConvertTo(Unit(info.Unit))
+		if err != nil {
+			return nil, err
+		}
+		quantity = converted
+	}
+
+	switch info.Type {
+	case FieldTypeNumber:
+		return quantity.Value, nil
+	case FieldTypeInt:
+		return int(math.Round(quantity.Value)), nil
+	case FieldTypeUint64:
+		if quantity.Value < 0 {
+			return nil, fmt.Errorf("%s is negative", quantity)
+		}
+		return uint64(math.Round(quantity.Value)), nil
+	}
+	return nil, fmt.Errorf("field %s.%s is not numeric", info.Region, info.Name)
+}
+
+// isNumericField returns true for fields that can hold a quantity
+func isNumericField(info FieldInfo) bool {
+	return info.Type == FieldTypeNumber || info.Type == FieldTypeInt || info.Type == FieldTypeUint64
+}
+
+// GetQuantity returns the value of a numeric field (see Get) as a quantity in the
+// unit of the field
+func (o *OpenPrintTag) GetQuantity(path string) (Quantity, bool, error) {
+	target, err := o.resolvePath(path, false)
+	if err != nil || target.region == nil {
+		return Quantity{}, false, err
+	}
+	if target.info == nil {
+		return Quantity{}, false, fmt.Errorf("field %s has no unit information", path)
+	}
+	if !isNumericField(*target.info) {
+		return Quantity{}, false, fmt.Errorf("field %s is not numeric", path)
+	}
+	field := target.field()
+	if field.IsNil() {
+		return Quantity{}, false, nil
+	}
+	value, err := coerceFloat(field.Elem().Interface())
+	if err != nil {
+		return Quantity{}, false, err
+	}
+	return Quantity{Value: value, Unit: Unit(target.info.Unit)}, true, nil
+}
+
+// SetQuantity sets a numeric field (see Set), converting the quantity to the unit of the field
+// Integer fields are rounded to the nearest whole number
+func (o *OpenPrintTag) SetQuantity(path string, quantity Quantity) error {
+	target, err := o.resolvePath(path, true)
+	if err != nil {
+		return err
+	}
+	if target.info == nil {
+		return fmt.Errorf("field %s has no unit information", path)
+	}
+	if !isNumericField(*target.info) {
+		return fmt.Errorf("field %s is not numeric", path)
+	}
+	value, err := quantityFieldValue(*target.info, quantity)
+	if err != nil {
+		return fmt.Errorf("field %s expects %s: %w", path, expectedFieldType(*target.info), err)
+	}
+	return o.Set(path, value)
+}
+
+// convertImportQuantity converts a quantity string given for a field in an imported data file
+// to the value of the field, ok is false where the field has no unit
+func convertImportQuantity(region, name, str string) (value any, ok bool, err error) {
+	info, found := LookupField(region, name)
+	if !found || info.Unit == "" {
+		return nil, false, nil
+	}
+	quantity, err := ParseQuantity(str)
+	if err == nil {
+		value, err = quantityFieldValue(info, quantity)
+	}
+	if err != nil {
+		return nil, true, fmt.Errorf("field %s.%s expects %s: %w", region, name, expectedFieldType(info), err)
+	}
+	return value, true, nil
+}
+
+// convertYAMLQuantities rewrites quantity strings (such as "1 kg") given for fields with
+// a unit within the data section of a YAML document to numbers in the unit of the field
+func convertYAMLQuantities(document *yaml.Node) error {
+	mappingValue := func(node *yaml.Node, key string) *yaml.Node {
+		if node == nil || node.Kind != yaml.MappingNode {
+			return nil
+		}
+		for idx := 0; idx+1 < len(node.Content); idx += 2 {
+			if node.Content[idx].Value == key {
+				return node.Content[idx+1]
+			}
+		}
+		return nil
+	}
+
+	if document.Kind != yaml.DocumentNode || len(document.Content) == 0 {
+		return nil
+	}
+	data := mappingValue(document.Content[0], "data")
+	for _, region := range []string{"meta", "main", "aux"} {
+		fields := mappingValue(data, region)
+		if fields == nil || fields.Kind != yaml.MappingNode {
+			continue
+		}
+		for idx := 0; idx+1 < len(fields.Content); idx += 2 {
+			node := fields.Content[idx+1]
+			if node.Kind != yaml.ScalarNode || node.ShortTag() != "!!str" {
+				continue
+			}
+			value, ok, err := convertImportQuantity(region, fields.Content[idx].Value, node.Value)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", node.Line, err)
+			}
+			if ok {
+				node.SetString(fmt.Sprint(value))
+				node.Tag = "!!float"
+				if _, isFloat := value.(float64); !isFloat {
+					node.Tag = "!!int"
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// convertJSONQuantities is the JSON equivalent of convertYAMLQuantities
+func convertJSONQuantities(document []byte) ([]byte, error) {
+	var top map[string]json.RawMessage
+	var data map[string]json.RawMessage
+	if json.Unmarshal(document, &top) != nil || json.Unmarshal(top["data"], &data) != nil {
+		// Not the expected structure, leave it to the decoder to complain
+		return document, nil
+	}
+
+	changed := false
+	for _, region := range []string{"meta", "main", "aux"} {
+		var fields map[string]json.RawMessage
+		if json.Unmarshal(data[region], &fields) != nil {
+			continue
+		}
+		regionChanged := false
+		for name, raw := range fields {
+			var str string
+			if json.Unmarshal(raw, &str) != nil {
+				continue
+			}
+			value, ok, err := convertImportQuantity(region, name, str)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				fields[name], _ = json.Marshal(value)
+				regionChanged = true
+			}
+		}
+		if regionChanged {
+			data[region], _ = json.Marshal(fields)
+			changed = true
+		}
+	}
+	if !changed {
+		return document, nil
+	}
+	top["data"], _ = json.Marshal(data)
+	return json.Marshal(top)
+}
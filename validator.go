@@ -28,42 +28,38 @@ import (
 	"strings"
 
 	st "github.com/cjbearman/openprinttag/structtags"
+	"github.com/google/uuid"
 )
 
 // Validate checks for missing required (error) or recommended (warnings)
 // fields in all regions
-func (o *OpenPrintTag) Validate() (errors []string, warnings []string) {
+func (o *OpenPrintTag) Validate() *Report {
+	report := &Report{}
 	if o.meta != nil {
-		e, w := validateRegion(o.meta)
-		errors = append(errors, e...)
-		warnings = append(warnings, w...)
+		validateRegion(o.meta, report)
 	}
 	if o.main != nil {
-		e, w := validateRegion(o.main)
-		errors = append(errors, e...)
-		warnings = append(warnings, w...)
+		validateRegion(o.main, report)
 	}
 	if o.aux != nil {
-		e, w := validateRegion(o.aux)
-		errors = append(errors, e...)
-		warnings = append(warnings, w...)
+		validateRegion(o.aux, report)
 		if o.auxRegionSize != 0 {
 			if o.auxRegionSize < 16 {
-				errors = append(errors, fmt.Sprintf("aux region size is set to %d bytes, specification requires a minimum of 16 bytes", o.auxRegionSize))
+				report.add(SeverityError, RuleAuxRegionSize, "aux region size is set to %d bytes, specification requires a minimum of 16 bytes", o.auxRegionSize)
 			} else if o.auxRegionSize < 32 {
-				warnings = append(warnings, fmt.Sprintf("aux region size is set to %d bytes, specification recommends at least 32 bytes for practical use", o.auxRegionSize))
+				report.add(SeverityWarning, RuleAuxRegionSize, "aux region size is set to %d bytes, specification recommends at least 32 bytes for practical use", o.auxRegionSize)
 			}
 		}
 	}
-	return
+	return report
 }
 
-// Validate will return any errors or warnings for a specific region
-func validateRegion(region Region) (errors, warnings []string) {
+// Validate will add any errors or warnings for a specific region to the report
+func validateRegion(region Region, report *Report) {
 	defer func() {
 		// Just in case something goes catastrophically wrong
 		if r := recover(); r != nil {
-			errors = append(errors, fmt.Sprintf("panic during validation: %v", r))
+			report.add(SeverityError, RuleInternalError, "panic during validation: %v", r)
 		}
 	}()
 
@@ -109,44 +105,116 @@ func validateRegion(region Region) (errors, warnings []string) {
 		// Is this required, if so error if nil
 		_, isRequired := tagMap[st.OptTagRequired]
 		if isRequired && valueIsNil {
-			errors = append(errors, genErrorOrWarning(name, key, nativeName, "is required"))
+			report.addFieldCheck(SeverityError, RuleRequiredFieldMissing, region, name, key, nativeName, "is required")
 		}
 
 		// Is this recommended, if so warning if nil
 		_, isRecommended := tagMap[st.OptTagRecommended]
 		if isRecommended && valueIsNil {
-			warnings = append(warnings, genErrorOrWarning(name, key, nativeName, "is recommended"))
+			report.addFieldCheck(SeverityWarning, RuleRecommendedFieldMissing, region, name, key, nativeName, "is recommended")
 		}
 	}
-	return
 }
 
-// OptCheck checks options for warnings and errors in all present regions
-func (o *OpenPrintTag) OptCheck() (errors []string, warnings []string) {
+// OptCheck checks options for warnings and errors in all present regions,
+// including plausibility checks across fields (see RuleCode).
+// Notes are informational, such as UUIDs that will be derived from other fields
+func (o *OpenPrintTag) OptCheck() *Report {
+	report := &Report{}
 	if o.meta != nil {
-		e, w := optCheck(o.meta)
-		errors = append(errors, e...)
-		warnings = append(warnings, w...)
+		optCheck(o.meta, report)
 	}
 	if o.main != nil {
-		e, w := optCheck(o.main)
-		errors = append(errors, e...)
-		warnings = append(warnings, w...)
+		optCheck(o.main, report)
 	}
 	if o.aux != nil {
-		e, w := optCheck(o.aux)
-		errors = append(errors, e...)
-		warnings = append(warnings, w...)
+		optCheck(o.aux, report)
+	} else {
+		report.add(SeverityNote, RuleAuxRegionMissing, "aux region is not present, so usage cannot be recorded on the tag")
 	}
-	return
+	o.uuidCheck(report)
+	o.plausibilityCheck(report)
+	return report
 }
 
-// optCheck returns errors and warnings for a specific region
-func optCheck(region Region) (errors, warnings []string) {
+// uuidCheck reports UUIDs that duplicate the value derived from other fields, and those that are
+// not present, noting whether they can be derived
+func (o *OpenPrintTag) uuidCheck(report *Report) {
+	if o.main == nil {
+		return
+	}
+	main := o.main
+
+	// checkDerived handles a UUID that may be derived from other fields, where derived is
+	// nil if it cannot be, and requires describes what is needed when it is not present
+	checkDerived := func(name string, value uuid.UUID, set bool, derived *uuid.UUID, source, requires string) {
+		info, _ := LookupField("main", name)
+		switch {
+		case set && derived != nil && *derived == value:
+			report.addField(SeverityWarning, RuleUUIDRedundant, info, "%s is identical to the auto-generated version, and thus can be omitted to save space", name)
+		case !set && derived != nil:
+			report.addField(SeverityNote, RuleUUIDDerived, info, "%s derived from %s", name, source)
+		case !set:
+			report.addField(SeverityWarning, RuleUUIDNotDeducible, info, "failed to deduce %s, %s", name, requires)
+		}
+	}
+
+	var brand *uuid.UUID
+	brandUUID, brandUUIDSet := main.GetBrandUuid()
+	if brandUUIDSet {
+		brand = &brandUUID
+	}
+	var derivedBrand *uuid.UUID
+	if brandName, found := main.GetBrandName(); found {
+		derived := BrandUUID(brandName)
+		derivedBrand = &derived
+		if brand == nil {
+			brand = derivedBrand
+		}
+	}
+	checkDerived("brand_uuid", brandUUID, brandUUIDSet, derivedBrand, "brand_name", "either brand_uuid or brand_name is required")
+
+	var derivedMaterial *uuid.UUID
+	if materialName, found := main.GetMaterialName(); found && brand != nil {
+		derived := MaterialUUID(materialName, *brand)
+		derivedMaterial = &derived
+	}
+	materialUUID, materialUUIDSet := main.GetMaterialUuid()
+	checkDerived("material_uuid", materialUUID, materialUUIDSet, derivedMaterial, "material_name and brand", "either material_uuid or material_name and brand_name (or brand_uuid) are required")
+
+	// An invalid GTIN is reported separately and does not identify a package
+	var derivedPackage *uuid.UUID
+	if gtin, found := main.GetGtin(); found && brand != nil && CheckGTIN(gtin) == nil {
+		derived := MaterialPackageUUID(strconv.FormatUint(gtin, 10), *brand)
+		derivedPackage = &derived
+	}
+	packageUUID, packageUUIDSet := main.GetPackageUuid()
+	checkDerived("package_uuid", packageUUID, packageUUIDSet, derivedPackage, "gtin and brand", "either package_uuid or gtin and brand_name (or brand_uuid) are required")
+
+	// The instance UUID is derived from the NFC UID by readers, which is only known for a physical tag
+	instanceUUID, instanceUUIDSet := main.GetInstanceUuid()
+	if o.tagUID == nil {
+		if !instanceUUIDSet {
+			info, _ := LookupField("main", "instance_uuid")
+			report.addField(SeverityNote, RuleUUIDDerived, info, "instance_uuid will be derived from the NFC UID when the tag is read")
+		}
+		return
+	}
+	derived, err := MaterialPackageInstanceUUID(o.tagUID)
+	if err != nil {
+		info, _ := LookupField("main", "instance_uuid")
+		report.addField(SeverityWarning, RuleUUIDNotDeducible, info, "failed to deduce instance_uuid from NFC UID: %v", err)
+		return
+	}
+	checkDerived("instance_uuid", instanceUUID, instanceUUIDSet, &derived, "NFC UID", "")
+}
+
+// optCheck adds errors and warnings for a specific region to the report
+func optCheck(region Region, report *Report) {
 	defer func() {
 		// Just in case something goes catastrophically wrong
 		if r := recover(); r != nil {
-			errors = append(errors, fmt.Sprintf("panic during validation: %v", r))
+			report.add(SeverityError, RuleInternalError, "panic during validation: %v", r)
 		}
 	}()
 
@@ -200,7 +268,7 @@ func optCheck(region Region) (errors, warnings []string) {
 				if value.Kind() == reflect.String || value.Kind() == reflect.Slice {
 					length = value.Len()
 					if length > maxLen {
-						errors = append(errors, genErrorOrWarning(name, key, nativeName, "has length %d which exceeds maximum length of %d for this field", length, maxLen))
+						report.addFieldCheck(SeverityError, RuleMaxLengthExceeded, region, name, key, nativeName, "has length %d which exceeds maximum length of %d for this field", length, maxLen)
 					}
 				}
 			}
@@ -211,53 +279,89 @@ func optCheck(region Region) (errors, warnings []string) {
 		if rgba {
 			length := value.Len()
 			if length != 3 && length != 4 {
-				errors = append(errors, genErrorOrWarning(name, key, nativeName, "has length %d which is not valid for RGBA fields (must be 3 or 4)", length))
+				report.addFieldCheck(SeverityError, RuleInvalidRGBALength, region, name, key, nativeName, "has length %d which is not valid for RGBA fields (must be 3 or 4)", length)
 			}
 		}
 
-	}
-	customErrors, customWarnings := getCustomErrorsAndWarnings(region)
-	errors = append(errors, customErrors...)
-	warnings = append(warnings, customWarnings...)
+		// Check for deprecated fields, which are still read and written but should be migrated
+		if _, deprecated := tagMap[st.OptTagDeprecated]; deprecated {
+			info, _ := LookupField(region.getRegionName(), nativeName)
+			report.addFieldCheck(SeverityWarning, RuleDeprecatedField, region, name, key, nativeName, "is deprecated%s", replacementHint(info.ReplacedBy))
+		}
 
-	return
+		for _, enum := range enumValues(value) {
+			switch {
+			case !enum.known:
+				// Retained, as the tag may have been written against a newer specification, so only warn
+				report.addFieldCheck(SeverityWarning, RuleUnknownEnumValue, region, name, key, nativeName, "has unknown enumeration value %d", enum.key)
+			case enum.info.Deprecated:
+				report.addFieldCheck(SeverityWarning, RuleDeprecatedEnumValue, region, name, key, nativeName, "has deprecated enumeration value %s%s", enum.info.Name, replacementHint(enum.info.ReplacedBy))
+			}
+		}
+	}
+	addCustomErrorsAndWarnings(region, report)
 }
 
-// GetCustomErrorsAndWarnings returns errors/warnings that cannot be auto-derived and must be hand coded
-func getCustomErrorsAndWarnings(region Region) (errors, warnings []string) {
+// addCustomErrorsAndWarnings adds errors/warnings that cannot be auto-derived and must be hand coded
+func addCustomErrorsAndWarnings(region Region, report *Report) {
 	if main, ok := region.(*MainRegion); ok {
-		brand, brandSet := main.GetBrandName()
-		brandUUID, brandUUIDSet := main.GetBrandUuid()
-
-		if brandSet && brandUUIDSet {
-			calculatedBrandUUID := BrandUUID(brand)
-			if calculatedBrandUUID.String() == brandUUID.String() {
-				warnings = append(warnings, "brand_uuid is identical to the auto-generated version, and thus can be omitted to save space")
+		gtin, gtinSet := main.GetGtin()
+		if gtinSet {
+			if err := CheckGTIN(gtin); err != nil {
+				info, _ := LookupField("main", "gtin")
+				report.addField(SeverityError, RuleInvalidGTIN, info, "gtin is invalid: %v", err)
 			}
 		}
+	}
+}
 
-		material, materialSet := main.GetMaterialName()
-		materialUUID, materialUUIDSet := main.GetMaterialUuid()
-		if materialSet && materialUUIDSet && (brandSet || brandUUIDSet) {
-			if !brandUUIDSet {
-				brandUUID = BrandUUID(brand)
-			}
-			calculatedMaterialUUID := MaterialUUID(material, brandUUID)
-			if calculatedMaterialUUID.String() == materialUUID.String() {
-				warnings = append(warnings, "material_uuid is identical to the auto-generated version, and thus can be omitted to save space")
-			}
+// addFieldCheck will add a suitably formatted field based issue given the region, name,
+// key and native name of the field (for consistency) and additional format and args
+func (r *Report) addFieldCheck(severity Severity, code RuleCode, region Region, name, key, nativeName, format string, v ...any) {
+	info := FieldInfo{Region: region.getRegionName(), Name: nativeName}
+	info.Key, _ = strconv.Atoi(key)
+	preamble := fmt.Sprintf("field %s (%s/%s) ", name, nativeName, key)
+	r.addField(severity, code, info, preamble+format, v...)
+}
+
+// enumValue is a value held by an enumeration field, with its specification information
+type enumValue struct {
+	key   uint64
+	info  EnumInfo
+	known bool
+}
+
+// enumValues returns the values held by an enumeration or enumeration array field,
+// none for any other kind of field
+func enumValues(value reflect.Value) (values []enumValue) {
+	type enumeration interface{ Info() (EnumInfo, bool) }
+	check := func(v reflect.Value) {
+		if v.Kind() != reflect.Uint64 || !v.Type().Implements(reflect.TypeOf((*enumeration)(nil)).Elem()) {
+			return
 		}
-		// TODO: Gtin and package need to be added
+		// Values reached through the unexported internal struct cannot be used as
+		// interfaces directly, so copy the value out first
+		e := reflect.New(v.Type()).Elem()
+		e.SetUint(v.Uint())
+		info, known := e.Interface().(enumeration).Info()
+		values = append(values, enumValue{key: v.Uint(), info: info, known: known})
+	}
+	if value.Kind() == reflect.Slice {
+		for idx := 0; idx < value.Len(); idx++ {
+			check(value.Index(idx))
+		}
+	} else {
+		check(value)
 	}
 	return
 }
 
-// genErrorOrWarning will return a suitably formatted field based error or warning
-// given the name, key and native name of the field (for consistency)
-// and additional format and args
-func genErrorOrWarning(name, key, nativeName, format string, v ...any) string {
-	preamble := fmt.Sprintf("field %s (%s/%s) ", name, nativeName, key)
-	return fmt.Sprintf(preamble+format, v...)
+// replacementHint returns the suffix for a deprecation warning naming the replacement, if any
+func replacementHint(replacedBy string) string {
+	if replacedBy == "" {
+		return ""
+	}
+	return fmt.Sprintf(", use %s instead", replacedBy)
 }
 
 // decodeOptTag takes the value of the opt struct tag and decodes it to a map
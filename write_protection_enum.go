@@ -24,6 +24,7 @@ package openprinttag
 // ** THIS FILE IS AUTO-GENERATED, DO NOT MODIFY **
 
 import (
+	"encoding/json"
 	"fmt"
 	"gopkg.in/yaml.v3"
 )
@@ -50,6 +51,25 @@ var WriteProtectionMap = map[uint64]string{
 	2: "protect_page_unlockable",
 }
 
+// writeProtectionInfo holds the specification information for all WriteProtection values
+var writeProtectionInfo = []EnumInfo{
+	{
+		Key:         0,
+		Name:        "no",
+		Description: "The tag is not write protected.",
+	},
+	{
+		Key:         1,
+		Name:        "irreversible",
+		Description: "The tag is irreversibly protected against writing.",
+	},
+	{
+		Key:         2,
+		Name:        "protect_page_unlockable",
+		Description: "The tag is write-protected using the `PROTECT PAGE` command (SLIX2-specific) and is unlockable with a password that is located somewhere on the container.",
+	},
+}
+
 func (e WriteProtection) String() string {
 	return WriteProtectionMap[uint64(e)]
 }
@@ -58,7 +78,8 @@ func (e WriteProtection) MarshalYAML() (any, error) {
 	if str, ok := WriteProtectionMap[uint64(e)]; ok {
 		return str, nil
 	}
-	return nil, fmt.Errorf("unknown enumeration: %d", e)
+	// Values unknown to this build are kept as their number
+	return uint64(e), nil
 }
 
 func (e *WriteProtection) UnmarshalYAML(value *yaml.Node) error {
@@ -66,13 +87,87 @@ func (e *WriteProtection) UnmarshalYAML(value *yaml.Node) error {
 	if err := value.Decode(&str); err != nil {
 		return err
 	}
+	key, err := enumKeyFromName(WriteProtectionMap, str)
+	if err != nil {
+		return err
+	}
+	*e = WriteProtection(key)
+	return nil
+}
+
+func (e WriteProtection) MarshalJSON() ([]byte, error) {
+	str, err := e.MarshalYAML()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(str)
+}
+
+func (e *WriteProtection) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		// Values unknown to this build are exported as numbers
+		var key uint64
+		if json.Unmarshal(data, &key) != nil {
+			return err
+		}
+		*e = WriteProtection(key)
+		return nil
+	}
+	key, err := enumKeyFromName(WriteProtectionMap, str)
+	if err != nil {
+		return err
+	}
+	*e = WriteProtection(key)
+	return nil
+}
+
+// Info returns the specification information for the value, false if the value is unknown
+func (e WriteProtection) Info() (EnumInfo, bool) {
+	return lookupEnumInfo(writeProtectionInfo, uint64(e))
+}
+
+// DisplayName returns the specification name
+// Values unknown to this build are rendered as their number
+func (e WriteProtection) DisplayName() string {
+	info, found := e.Info()
+	if !found {
+		return fmt.Sprintf("%d", e)
+	}
+	return info.Name
+}
+
+// Description returns the specification description
+func (e WriteProtection) Description() string {
+	info, _ := e.Info()
+	return info.Description
+}
+
+// Abbreviation returns the abbreviation, if any
+func (e WriteProtection) Abbreviation() string {
+	info, _ := e.Info()
+	return info.Abbreviation
+}
+
+// IsDeprecated returns true if the specification has deprecated the value
+func (e WriteProtection) IsDeprecated() bool {
+	info, _ := e.Info()
+	return info.Deprecated
+}
+
+// ParseWriteProtection parses a value from its name, abbreviation or numeric key, ignoring case
+func ParseWriteProtection(str string) (WriteProtection, error) {
+	key, err := parseEnum(writeProtectionInfo, str)
+	return WriteProtection(key), err
+}
 
-	// Hardly efficient, but this is not critical here
-	for key, name := range WriteProtectionMap {
-		if name == str {
-			*e = WriteProtection(key)
-			return nil
+// AllWriteProtection returns all values that are not deprecated, in specification order
+func AllWriteProtection() []WriteProtection {
+	values := make([]WriteProtection, 0, len(writeProtectionInfo))
+	for _, info := range writeProtectionInfo {
+		if !info.Deprecated {
+			values = append(values, WriteProtection(info.Key))
 		}
 	}
-	return fmt.Errorf("unknown enumeration: %s", str)
+	return values
 }
@@ -23,6 +23,7 @@
 package openprinttag
 
 import (
+	"encoding/json"
 	"fmt"
 	"slices"
 
@@ -40,48 +41,107 @@ const (
 	IncludeURI
 	IncludeUUIDs
 	IncludeAll
+	IncludeComputed
 )
 
 // data provides the encoder/decoder for the tag data sections
 type data struct {
-	Meta *metaInternal `yaml:"meta,omitempty"`
-	Main *mainInternal `yaml:"main,omitempty"`
-	Aux  *auxInternal  `yaml:"aux,omitempty"`
+	Meta *metaInternal `yaml:"meta,omitempty" json:"meta,omitempty"`
+	Main *mainInternal `yaml:"main,omitempty" json:"main,omitempty"`
+	Aux  *auxInternal  `yaml:"aux,omitempty" json:"aux,omitempty"`
 }
 
 type validate struct {
-	Warnings []string `yaml:"warnings"`
-	Errors   []string `yaml:"errors"`
+	Warnings []Issue `yaml:"warnings" json:"warnings"`
+	Errors   []Issue `yaml:"errors" json:"errors"`
 }
 
 type optcheck struct {
-	Warnings []string `yaml:"warnings"`
-	Errors   []string `yaml:"errors"`
-	Notes    []string `yaml:"notes"`
+	Warnings []Issue `yaml:"warnings" json:"warnings"`
+	Errors   []Issue `yaml:"errors" json:"errors"`
+	Notes    []Issue `yaml:"notes" json:"notes"`
+}
+
+// validateFields and optcheckFields decode the sections without recursing into their decoders
+type validateFields validate
+type optcheckFields optcheck
+
+func (v *validate) UnmarshalYAML(value *yaml.Node) error {
+	if err := value.Decode((*validateFields)(v)); err != nil {
+		return err
+	}
+	v.setLegacySeverities()
+	return nil
+}
+
+func (v *validate) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*validateFields)(v)); err != nil {
+		return err
+	}
+	v.setLegacySeverities()
+	return nil
+}
+
+func (v *validate) setLegacySeverities() {
+	setLegacySeverity(v.Warnings, SeverityWarning)
+	setLegacySeverity(v.Errors, SeverityError)
+}
+
+func (c *optcheck) UnmarshalYAML(value *yaml.Node) error {
+	if err := value.Decode((*optcheckFields)(c)); err != nil {
+		return err
+	}
+	c.setLegacySeverities()
+	return nil
+}
+
+func (c *optcheck) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*optcheckFields)(c)); err != nil {
+		return err
+	}
+	c.setLegacySeverities()
+	return nil
+}
+
+func (c *optcheck) setLegacySeverities() {
+	setLegacySeverity(c.Warnings, SeverityWarning)
+	setLegacySeverity(c.Errors, SeverityError)
+	setLegacySeverity(c.Notes, SeverityNote)
+}
+
+// setLegacySeverity gives plain messages, which carry no code or severity of their own,
+// the severity of the section they were read from
+func setLegacySeverity(issues []Issue, severity Severity) {
+	for i := range issues {
+		if issues[i].Code == "" {
+			issues[i].Severity = severity
+		}
+	}
 }
 
 type regionStats struct {
-	Meta RegionStat  `yaml:"meta"`
-	Main RegionStat  `yaml:"main"`
-	Aux  *RegionStat `yaml:"aux,omitempty"`
+	Meta RegionStat  `yaml:"meta" json:"meta"`
+	Main RegionStat  `yaml:"main" json:"main"`
+	Aux  *RegionStat `yaml:"aux,omitempty" json:"aux,omitempty"`
 }
 
 type uuids struct {
-	Brand    *string `yaml:"brand_uuid"`
-	Material *string `yaml:"material_uuid"`
-	Package  *string `yaml:"package_uuid"`
-	Instance *string `yaml:"instance_uuid"`
+	Brand    *string `yaml:"brand_uuid" json:"brand_uuid"`
+	Material *string `yaml:"material_uuid" json:"material_uuid"`
+	Package  *string `yaml:"package_uuid" json:"package_uuid"`
+	Instance *string `yaml:"instance_uuid" json:"instance_uuid"`
 }
 
 // yamlJsonEncoder provides an encoder/decoder for our open print tag
 type YamlEncoder struct {
-	Regions   *regionStats `yaml:"regions,omitempty"`
-	Root      *RootStat    `yaml:"root,omitempty"`
-	Data      data         `yaml:"data"`
-	UriRecord *string      `yaml:"uri,omitempty"`
-	Validate  *validate    `yaml:"validate,omitempty"`
-	OptCheck  *optcheck    `yaml:"opt_check,omitempty"`
-	UUIDS     *uuids       `yaml:"uuids,omitempty"`
+	Regions   *regionStats    `yaml:"regions,omitempty" json:"regions,omitempty"`
+	Root      *RootStat       `yaml:"root,omitempty" json:"root,omitempty"`
+	Data      data            `yaml:"data" json:"data"`
+	UriRecord *string         `yaml:"uri,omitempty" json:"uri,omitempty"`
+	Validate  *validate       `yaml:"validate,omitempty" json:"validate,omitempty"`
+	OptCheck  *optcheck       `yaml:"opt_check,omitempty" json:"opt_check,omitempty"`
+	UUIDS     *uuids          `yaml:"uuids,omitempty" json:"uuids,omitempty"`
+	Computed  *ComputedValues `yaml:"computed,omitempty" json:"computed,omitempty"`
 }
 
 // prepare will prepare an open print tag representation
@@ -112,18 +172,25 @@ func (o *OpenPrintTag) prepare(opts ...YAMLOption) *YamlEncoder {
 	}
 
 	if slices.Contains(opts, IncludeValidation) || slices.Contains(opts, IncludeAll) {
-		encoder.Validate = &validate{}
-		encoder.Validate.Errors, encoder.Validate.Warnings = o.Validate()
+		report := o.Validate()
+		encoder.Validate = &validate{
+			Errors:   report.WithSeverity(SeverityError).Issues,
+			Warnings: report.WithSeverity(SeverityWarning).Issues,
+		}
 	}
 
 	if slices.Contains(opts, IncludeOptCheck) || slices.Contains(opts, IncludeAll) {
-		encoder.OptCheck = &optcheck{}
-		encoder.OptCheck.Errors, encoder.OptCheck.Warnings = o.OptCheck()
+		report := o.OptCheck()
+		encoder.OptCheck = &optcheck{
+			Errors:   report.WithSeverity(SeverityError).Issues,
+			Warnings: report.WithSeverity(SeverityWarning).Issues,
+			Notes:    report.WithSeverity(SeverityNote).Issues,
+		}
 	}
 
 	if slices.Contains(opts, IncludeURI) || slices.Contains(opts, IncludeAll) {
-		if o.uri != "" {
-			encoder.UriRecord = &o.uri
+		if uriValue := o.uriRecord(); uriValue != "" {
+			encoder.UriRecord = &uriValue
 		}
 	}
 
@@ -143,6 +210,10 @@ func (o *OpenPrintTag) prepare(opts ...YAMLOption) *YamlEncoder {
 		o.getUUIDInformation(encoder.UUIDS)
 	}
 
+	if slices.Contains(opts, IncludeComputed) || slices.Contains(opts, IncludeAll) {
+		encoder.Computed = o.ComputeValues()
+	}
+
 	return &encoder
 }
 
@@ -179,18 +250,25 @@ func (o *OpenPrintTag) getUUIDInformation(uuids *uuids) {
 	}
 
 	uuids.Package = fromUUIDPtr(o.main.GetPackageUuid)
-	if gtin, found := o.main.GetGtin(); uuids.Package == nil && brandKnown && found {
+	if gtin, found := o.main.GetGtin(); uuids.Package == nil && brandKnown && found && CheckGTIN(gtin) == nil {
 		str := MaterialPackageUUID(fmt.Sprintf("%d", gtin), brand).String()
 		uuids.Package = &str
 	}
 
 	uuids.Instance = fromUUIDPtr(o.main.GetInstanceUuid)
+	if uuids.Instance == nil && o.tagUID != nil {
+		if instance, err := MaterialPackageInstanceUUID(o.tagUID); err == nil {
+			str := instance.String()
+			uuids.Instance = &str
+		}
+	}
 }
 
 // ToYAML returns a YAML representation of the tag
 // with optional options consisting of:
 // IncludeValidation - Includes output from validation
 // IncludeOptCheck - Includes output from opt check
+// IncludeComputed - Includes values derived from the data (see ComputeValues), for reading only
 // IncludeAll - Includes everything
 func (o *OpenPrintTag) ToYAML(opts ...YAMLOption) (string, error) {
 	obj := o.prepare(opts...)
@@ -199,13 +277,24 @@ func (o *OpenPrintTag) ToYAML(opts ...YAMLOption) (string, error) {
 }
 
 // FromYAML reads a tag from YAML representation
+// Values for fields with a unit may be given as quantities, such as "1 kg" or "410 F",
+// and are converted to the unit of the field
 func FromYAML(yamlData string) (*OpenPrintTag, error) {
-	obj := YamlEncoder{}
-	err := yaml.Unmarshal([]byte(yamlData), &obj)
-	if err != nil {
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(yamlData), &document); err != nil {
+		return nil, err
+	}
+	if err := convertYAMLQuantities(&document); err != nil {
 		return nil, err
 	}
 
+	obj := YamlEncoder{}
+	if document.Kind != 0 {
+		if err := document.Decode(&obj); err != nil {
+			return nil, err
+		}
+	}
+
 	return reconstruct(obj), nil
 }
 
@@ -213,7 +302,7 @@ func FromYAML(yamlData string) (*OpenPrintTag, error) {
 func reconstruct(from YamlEncoder) *OpenPrintTag {
 	opt := NewOpenPrintTag()
 	if from.UriRecord != nil {
-		opt.uri = *from.UriRecord
+		opt.setURIRecord(*from.UriRecord)
 	}
 	if from.Data.Meta != nil {
 		opt.meta.internal = *from.Data.Meta
@@ -222,7 +311,8 @@ func reconstruct(from YamlEncoder) *OpenPrintTag {
 		opt.main.internal = *from.Data.Main
 	}
 	if from.Data.Aux != nil {
-		opt.aux = &AuxRegion{internal: *from.Data.Aux}
+		opt.aux = newAuxRegion()
+		opt.aux.internal = *from.Data.Aux
 	}
 
 	return opt